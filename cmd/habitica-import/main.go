@@ -0,0 +1,54 @@
+// Command habitica-import writes Terraform configuration that imports an
+// existing Habitica account's tags, tasks and webhooks.
+//
+// Credentials are read from the same environment variables as the provider:
+// HABITICA_USER_ID, HABITICA_API_TOKEN, HABITICA_CLIENT_AUTHOR_ID and,
+// optionally, HABITICA_BASE_URL.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/importgen"
+)
+
+func main() {
+	outDir := flag.String("out", ".", "directory to write imports.tf and resources.tf to")
+	flag.Parse()
+
+	config := client.Config{
+		UserID:         os.Getenv("HABITICA_USER_ID"),
+		APIKey:         os.Getenv("HABITICA_API_TOKEN"),
+		ClientAuthorID: os.Getenv("HABITICA_CLIENT_AUTHOR_ID"),
+		BaseURL:        os.Getenv("HABITICA_BASE_URL"),
+	}
+	if config.UserID == "" || config.APIKey == "" || config.ClientAuthorID == "" {
+		log.Fatal("Set HABITICA_USER_ID, HABITICA_API_TOKEN and HABITICA_CLIENT_AUTHOR_ID")
+	}
+
+	out, err := importgen.Generate(context.Background(), client.New(config))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		log.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"imports.tf":   out.Imports,
+		"resources.tf": out.Resources,
+	} {
+		if err := os.WriteFile(filepath.Join(*outDir, name), []byte(content), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Generated %s (delete after apply)\n", filepath.Join(*outDir, "imports.tf"))
+	fmt.Fprintf(os.Stderr, "Generated %s (keep)\n", filepath.Join(*outDir, "resources.tf"))
+}
@@ -14,6 +14,18 @@ provider "habitica" {
   client_author_id = var.habitica_client_author_id
   client_app_name  = "TerraformHabitica"
   rate_limit_buffer = 5
+
+  # Shared by every resource in the run
+  requests_per_minute     = 30
+  max_concurrent_requests = 4
+
+  # Point at a self-hosted instance and tune retries, e.g. for CI
+  # base_url         = "https://habitica.example.com/api/v3"
+  # max_retries      = 3
+  # retry_base_delay = "1s"
+  # retry_jitter     = 0.2
+  # request_timeout  = "30s"
+  # cache_ttl        = "5m"
 }
 
 variable "habitica_user_id" {
@@ -41,9 +53,17 @@ resource "habitica_tag" "work" {
   name = "Work"
 }
 
+# Tags created outside Terraform, e.g. in the app or by a challenge
+data "habitica_tag" "reading" {
+  name = "Reading"
+}
+
+data "habitica_tags" "all" {}
+
 # Habits - positive/negative scoring
 resource "habitica_habit" "water" {
   text     = "Drink water"
+  alias    = "drink-water" # Score with POST /tasks/drink-water/score/up
   notes    = "Stay hydrated throughout the day"
   priority = 1    # Easy difficulty
   up       = true
@@ -51,19 +71,32 @@ resource "habitica_habit" "water" {
   tags     = [habitica_tag.health.id]
 }
 
+resource "habitica_habit" "read" {
+  text = "Read a chapter"
+  tags = [data.habitica_tag.reading.id]
+}
+
 resource "habitica_habit" "posture" {
-  text     = "Good posture"
-  notes    = "Maintain good posture at desk"
-  priority = 1
-  up       = true
-  down     = true  # Can also score negatively for slouching
-  tags     = [habitica_tag.health.id, habitica_tag.work.id]
+  text      = "Good posture"
+  notes     = "Maintain good posture at desk"
+  priority  = 1
+  up        = true
+  down      = true  # Can also score negatively for slouching
+  frequency = "weekly" # Counters reset every week
+  tags      = [habitica_tag.health.id, habitica_tag.work.id]
+
+  # Fail instead of waiting indefinitely behind the rate limit
+  timeouts {
+    create = "5m"
+    update = "5m"
+  }
 }
 
 # Dailies - recurring scheduled tasks
 resource "habitica_daily" "morning_workout" {
   text       = "Morning workout"
   notes      = "30 minutes of exercise"
+  attribute  = "con" # Trains constitution
   priority   = 1.5  # Medium difficulty
   frequency  = "weekly"
   every_x    = 1
@@ -79,13 +112,24 @@ resource "habitica_daily" "morning_workout" {
     sunday    = false
   }
 
+  reminders = [
+    { time = "06:30" },  # UTC
+  ]
+
+  # Item IDs and check-offs made in the app survive applies
+  checklist = [
+    { text = "Warm up" },
+    { text = "Strength" },
+    { text = "Stretch" },
+  ]
+
   tags = [habitica_tag.health.id]
 }
 
 resource "habitica_daily" "monthly_review" {
   text          = "Monthly review"
   notes         = "Review goals and progress"
-  priority      = 2  # Hard difficulty
+  difficulty    = "hard" # Same as priority = 2
   frequency     = "monthly"
   every_x       = 1
   days_of_month = [1]  # First day of each month
@@ -93,6 +137,30 @@ resource "habitica_daily" "monthly_review" {
   tags = [habitica_tag.work.id]
 }
 
+# Todos - one-off tasks with optional due date and checklist
+resource "habitica_todo" "onboarding" {
+  text     = "Complete onboarding"
+  notes    = "First week setup"
+  priority = 1
+  date     = "2025-02-01"
+
+  checklist = [
+    { text = "Set up laptop" },
+    { text = "Request VPN access" },
+    { text = "Read team handbook" },
+  ]
+
+  tags = [habitica_tag.work.id]
+}
+
+# Rewards - custom items bought with gold
+resource "habitica_reward" "coffee" {
+  text  = "Fancy coffee"
+  notes = "Treat yourself"
+  value = 25  # Gold cost
+  tags  = [habitica_tag.work.id]
+}
+
 # Webhooks - event notifications
 resource "habitica_webhook" "task_notifications" {
   url     = "https://example.com/habitica-webhook"
@@ -116,3 +184,29 @@ output "health_tag_id" {
 output "work_tag_id" {
   value = habitica_tag.work.id
 }
+
+output "challenge_tag_names" {
+  value = [for t in data.habitica_tags.all.tags : t.name if t.challenge]
+}
+
+# Dailies tagged "Work" that are still open today
+data "habitica_tasks" "work_today" {
+  type      = "daily"
+  tag       = "Work"
+  due_today = true
+  completed = false
+}
+
+output "work_today" {
+  value = [for t in data.habitica_tasks.work_today.tasks : "${t.text} (streak ${t.streak})"]
+}
+
+# A single task created outside Terraform, e.g. a challenge habit
+data "habitica_task" "yoga" {
+  text = "30 minutes of yoga"
+  type = "habit"
+}
+
+output "yoga_counter" {
+  value = data.habitica_task.yoga.counter_up
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long a bulk fetch of tasks or tags is reused.
+const DefaultCacheTTL = 5 * time.Minute
+
+// entityCache holds every entity of one kind, filled by a single bulk fetch.
+// Concurrent misses share one fetch, entries expire together after the TTL,
+// and writes update the affected entry in place instead of emptying the cache.
+type entityCache[T any] struct {
+	ttl   time.Duration
+	key   func(*T) string
+	fetch func(context.Context) ([]T, error)
+	now   func() time.Time
+
+	mu        sync.RWMutex
+	data      *cacheData[T] // nil until the first fetch completes
+	fetchedAt time.Time
+	flight    *cacheFlight
+	// pending holds writes made while a fetch was in flight, replayed onto
+	// its result so that the fetch cannot resurrect stale entries.
+	pending []func(*cacheData[T])
+}
+
+// cacheData holds the cached entities in the order the API returned them.
+type cacheData[T any] struct {
+	items map[string]*T
+	order []string
+}
+
+type cacheFlight struct {
+	done chan struct{}
+	err  error
+}
+
+func newEntityCache[T any](ttl time.Duration, key func(*T) string, fetch func(context.Context) ([]T, error)) *entityCache[T] {
+	return &entityCache[T]{ttl: ttl, key: key, fetch: fetch, now: time.Now}
+}
+
+func (c *entityCache[T]) fresh() bool {
+	return c.data != nil && c.now().Sub(c.fetchedAt) < c.ttl
+}
+
+// get returns the cached entity with the given ID, filling the cache first if
+// it is empty or expired. The boolean is false if the entity does not exist.
+func (c *entityCache[T]) get(ctx context.Context, id string) (*T, bool, error) {
+	if err := c.load(ctx); err != nil {
+		return nil, false, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	item, ok := c.data.items[id]
+	if !ok {
+		return nil, false, nil
+	}
+	// Callers may modify what they get, so hand out a copy as list does
+	v := *item
+	return &v, true, nil
+}
+
+// list returns all cached entities in API order, filling the cache first if
+// it is empty or expired.
+func (c *entityCache[T]) list(ctx context.Context) ([]T, error) {
+	if err := c.load(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	items := make([]T, 0, len(c.data.order))
+	for _, id := range c.data.order {
+		items = append(items, *c.data.items[id])
+	}
+	return items, nil
+}
+
+// load fills the cache unless it is fresh, sharing one fetch among callers.
+func (c *entityCache[T]) load(ctx context.Context) error {
+	for {
+		c.mu.Lock()
+		if c.fresh() {
+			c.mu.Unlock()
+			return nil
+		}
+
+		if f := c.flight; f != nil {
+			c.mu.Unlock()
+			select {
+			case <-f.done:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			// The fetch ran with another caller's context; only our own
+			// cancellation is worth passing on
+			if f.err != nil && isContextError(f.err) && ctx.Err() == nil {
+				continue
+			}
+			return f.err
+		}
+
+		f := &cacheFlight{done: make(chan struct{})}
+		c.flight = f
+		c.pending = nil
+		c.mu.Unlock()
+
+		items, err := c.fetch(ctx)
+
+		c.mu.Lock()
+		if err == nil {
+			data := &cacheData[T]{
+				items: make(map[string]*T, len(items)),
+				order: make([]string, 0, len(items)),
+			}
+			for i := range items {
+				id := c.key(&items[i])
+				if _, ok := data.items[id]; !ok {
+					data.order = append(data.order, id)
+				}
+				data.items[id] = &items[i]
+			}
+			for _, write := range c.pending {
+				write(data)
+			}
+			c.data = data
+			c.fetchedAt = c.now()
+		}
+		c.flight = nil
+		c.pending = nil
+		c.mu.Unlock()
+
+		f.err = err
+		close(f.done)
+		return err
+	}
+}
+
+// update applies a write to the cached entities, and to the result of any
+// fetch still in flight.
+func (c *entityCache[T]) update(write func(*cacheData[T])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.data != nil {
+		write(c.data)
+	}
+	if c.flight != nil {
+		c.pending = append(c.pending, write)
+	}
+}
+
+// put stores a copy of the latest version of an entity returned by a write.
+func (c *entityCache[T]) put(item *T) {
+	id := c.key(item)
+	v := *item
+	item = &v
+	c.update(func(data *cacheData[T]) {
+		if _, ok := data.items[id]; !ok {
+			data.order = append(data.order, id)
+		}
+		data.items[id] = item
+	})
+}
+
+// remove drops a deleted entity.
+func (c *entityCache[T]) remove(id string) {
+	c.update(func(data *cacheData[T]) {
+		if _, ok := data.items[id]; ok {
+			delete(data.items, id)
+			data.order = slices.DeleteFunc(data.order, func(o string) bool { return o == id })
+		}
+	})
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
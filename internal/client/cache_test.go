@@ -0,0 +1,536 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func taskKey(t *Task) string { return t.ID }
+
+func TestEntityCacheSingleflight(t *testing.T) {
+	var fetches atomic.Int32
+	release := make(chan struct{})
+
+	fetch := func(ctx context.Context) ([]Task, error) {
+		fetches.Add(1)
+		<-release
+		return []Task{{ID: "task-1"}, {ID: "task-2"}}, nil
+	}
+
+	cache := newEntityCache(time.Minute, taskKey, fetch)
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			task, ok, err := cache.get(context.Background(), fmt.Sprintf("task-%d", i%2+1))
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.NotNil(t, task)
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), fetches.Load())
+}
+
+func TestEntityCacheTTL(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	fetches := 0
+
+	fetch := func(ctx context.Context) ([]Task, error) {
+		fetches++
+		return []Task{{ID: "task-1"}}, nil
+	}
+
+	cache := newEntityCache(time.Minute, taskKey, fetch)
+	cache.now = func() time.Time { return now }
+
+	_, _, err := cache.get(context.Background(), "task-1")
+	require.NoError(t, err)
+
+	now = now.Add(59 * time.Second)
+	_, _, err = cache.get(context.Background(), "task-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetches)
+
+	now = now.Add(time.Second)
+	_, _, err = cache.get(context.Background(), "task-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, fetches, "expired cache is fetched again")
+}
+
+// TestEntityCacheCopies validates that callers modifying an entity they got
+// from or put into the cache do not modify the cached entity
+func TestEntityCacheCopies(t *testing.T) {
+	fetch := func(ctx context.Context) ([]Task, error) {
+		return []Task{{ID: "task-1", Text: "Stretch"}}, nil
+	}
+	cache := newEntityCache(time.Minute, taskKey, fetch)
+	ctx := context.Background()
+
+	task, ok, err := cache.get(ctx, "task-1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	task.Text = "Changed"
+
+	written := &Task{ID: "task-2", Text: "Run"}
+	cache.put(written)
+	written.Text = "Changed"
+
+	task, _, err = cache.get(ctx, "task-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Stretch", task.Text)
+	task, _, err = cache.get(ctx, "task-2")
+	require.NoError(t, err)
+	assert.Equal(t, "Run", task.Text)
+}
+
+// TestEntityCacheWriteDuringFetch validates that a write racing a bulk fetch
+// is not lost when the (older) fetch result lands
+func TestEntityCacheWriteDuringFetch(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	fetch := func(ctx context.Context) ([]Task, error) {
+		close(started)
+		<-release
+		return []Task{{ID: "task-1", Text: "Stale"}, {ID: "task-2"}}, nil
+	}
+
+	cache := newEntityCache(time.Minute, taskKey, fetch)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _, err := cache.get(context.Background(), "task-1")
+		assert.NoError(t, err)
+	}()
+
+	<-started
+	cache.put(&Task{ID: "task-1", Text: "Fresh"})
+	cache.remove("task-2")
+	close(release)
+	<-done
+
+	task, ok, err := cache.get(context.Background(), "task-1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "Fresh", task.Text)
+
+	_, ok, err = cache.get(context.Background(), "task-2")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+// TestEntityCacheWaiterOutlivesCancelledFetch validates that a caller waiting
+// on another caller's fetch retries if only the other caller was cancelled
+func TestEntityCacheWaiterOutlivesCancelledFetch(t *testing.T) {
+	var fetches atomic.Int32
+	started := make(chan struct{})
+
+	fetch := func(ctx context.Context) ([]Task, error) {
+		if fetches.Add(1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return []Task{{ID: "task-1"}}, nil
+	}
+
+	cache := newEntityCache(time.Minute, taskKey, fetch)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, _, err := cache.get(ctx, "task-1")
+		first <- err
+	}()
+	<-started
+
+	second := make(chan bool)
+	go func() {
+		_, ok, err := cache.get(context.Background(), "task-1")
+		assert.NoError(t, err)
+		second <- ok
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	assert.ErrorIs(t, <-first, context.Canceled)
+	assert.True(t, <-second)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+// TestClientCacheLargePlan validates the request count for refreshing,
+// updating and refreshing again 50 dailies
+func TestClientCacheLargePlan(t *testing.T) {
+	const count = 50
+
+	var mu sync.Mutex
+	requests := map[string]int{}
+
+	tasks := make([]Task, count)
+	for i := range tasks {
+		tasks[i] = Task{ID: fmt.Sprintf("daily-%d", i), Type: "daily", Text: fmt.Sprintf("Daily %d", i)}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method+" "+strings.SplitN(r.URL.Path, "/", 3)[1]]++
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(APIResponse[[]Task]{Success: true, Data: tasks})
+		case http.MethodPut:
+			var task Task
+			json.NewDecoder(r.Body).Decode(&task)
+			task.ID = strings.TrimPrefix(r.URL.Path, "/tasks/")
+			json.NewEncoder(w).Encode(APIResponse[Task]{Success: true, Data: task})
+		}
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:                "test-user",
+		APIKey:                "test-key",
+		ClientAuthorID:        "test-author",
+		RequestsPerMinute:     1000,
+		MaxConcurrentRequests: 10,
+		BaseURL:               server.URL,
+	})
+
+	// Terraform runs up to 10 operations at once
+	parallel := func(fn func(id string)) {
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, 10)
+		for i := range count {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				fn(fmt.Sprintf("daily-%d", i))
+			}()
+		}
+		wg.Wait()
+	}
+
+	// Refresh
+	parallel(func(id string) {
+		_, err := client.GetTask(context.Background(), id)
+		assert.NoError(t, err)
+	})
+
+	// Apply
+	parallel(func(id string) {
+		_, err := client.UpdateTask(context.Background(), id, &UpdateTaskRequest{Text: "Renamed " + id})
+		assert.NoError(t, err)
+	})
+
+	// Refresh after apply sees the updates without fetching again
+	parallel(func(id string) {
+		task, err := client.GetTask(context.Background(), id)
+		assert.NoError(t, err)
+		assert.Equal(t, "Renamed "+id, task.Text)
+	})
+
+	assert.Equal(t, map[string]int{"GET tasks": 1, "PUT tasks": count}, requests)
+}
+
+func TestClientDeleteTagUpdatesCachedTasks(t *testing.T) {
+	fetches := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			fetches++
+			w.Write([]byte(`{"success":true,"data":[{"id":"task-1","type":"habit","text":"Exercise","tags":["tag-1","tag-2"]}]}`))
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+
+	before, err := client.GetTask(context.Background(), "task-1")
+	require.NoError(t, err)
+
+	require.NoError(t, client.DeleteTag(context.Background(), "tag-1"))
+
+	after, err := client.GetTask(context.Background(), "task-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tag-2"}, after.Tags)
+	assert.Equal(t, []string{"tag-1", "tag-2"}, before.Tags, "tasks already handed out are not modified")
+	assert.Equal(t, 1, fetches)
+}
+
+func TestClientListTasksQuery(t *testing.T) {
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":[]}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+	ctx := context.Background()
+	dueDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	for range 2 {
+		_, err := client.ListTasks(ctx, ListTasksOptions{Type: "daily"})
+		require.NoError(t, err)
+		_, err = client.ListTasks(ctx, ListTasksOptions{Type: "daily", DueDate: dueDate})
+		require.NoError(t, err)
+	}
+	_, err := client.ListTasks(ctx, ListTasksOptions{IncludeCompletedTodos: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"type=dailys",
+		"dueDate=2024-01-15&type=dailys",
+		"dueDate=2024-01-15&type=dailys", // Due dates are not cached
+		"",
+		"type=completedTodos",
+	}, queries)
+
+	_, err = client.ListTasks(ctx, ListTasksOptions{Type: "completedTodo"})
+	assert.ErrorContains(t, err, "unknown task type")
+}
+
+// TestClientListTasksTypeCaches validates that writes keep each type's cache
+// current, including todos moving to the completed list
+func TestClientListTasksTypeCaches(t *testing.T) {
+	fetches := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodPut {
+			w.Write([]byte(`{"success":true,"data":{"id":"todo-1","type":"todo","text":"Taxes","completed":true}}`))
+			return
+		}
+
+		taskType := r.URL.Query().Get("type")
+		fetches[taskType]++
+		switch taskType {
+		case "todos":
+			w.Write([]byte(`{"success":true,"data":[{"id":"todo-1","type":"todo","text":"Taxes"}]}`))
+		case "completedTodos":
+			w.Write([]byte(`{"success":true,"data":[{"id":"todo-0","type":"todo","text":"Groceries","completed":true}]}`))
+		default:
+			w.Write([]byte(`{"success":true,"data":[{"id":"habit-1","type":"habit","text":"Exercise"},{"id":"todo-1","type":"todo","text":"Taxes"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+	ctx := context.Background()
+
+	ids := func(tasks []Task) []string {
+		var ids []string
+		for _, task := range tasks {
+			ids = append(ids, task.ID)
+		}
+		return ids
+	}
+
+	todos, err := client.ListTasks(ctx, ListTasksOptions{Type: "todo", IncludeCompletedTodos: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"todo-1", "todo-0"}, ids(todos))
+
+	all, err := client.ListTasks(ctx, ListTasksOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"habit-1", "todo-1"}, ids(all))
+
+	_, err = client.UpdateTask(ctx, "todo-1", &UpdateTaskRequest{Text: "Taxes"})
+	require.NoError(t, err)
+
+	todos, err = client.ListTasks(ctx, ListTasksOptions{Type: "todo"})
+	require.NoError(t, err)
+	assert.Empty(t, todos)
+
+	all, err = client.ListTasks(ctx, ListTasksOptions{IncludeCompletedTodos: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"habit-1", "todo-0", "todo-1"}, ids(all))
+
+	completed, err := client.GetTask(ctx, "todo-1")
+	require.NoError(t, err)
+	assert.True(t, completed.Completed)
+
+	assert.Equal(t, map[string]int{"todos": 1, "completedTodos": 1, "": 1}, fetches)
+}
+
+func TestClientGetTaskByAlias(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tasks/user" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"success":false,"error":"NotFound","message":"Task not found."}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("type") == "completedTodos" {
+			w.Write([]byte(`{"success":true,"data":[{"id":"todo-1","type":"todo","text":"Taxes","alias":"taxes","completed":true}]}`))
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":[{"id":"habit-1","type":"habit","text":"Drink water","alias":"drink-water"}]}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+	ctx := context.Background()
+
+	task, err := client.GetTask(ctx, "drink-water")
+	require.NoError(t, err)
+	assert.Equal(t, "habit-1", task.ID)
+
+	task, err = client.GetTask(ctx, "taxes")
+	require.NoError(t, err)
+	assert.Equal(t, "todo-1", task.ID)
+
+	_, err = client.GetTask(ctx, "stretch")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// TestClientGetTaskConfirmsMisses validates that a task missing from the
+// lists, such as an old completed todo Habitica no longer lists, is fetched
+// directly instead of being reported as deleted
+func TestClientGetTaskConfirmsMisses(t *testing.T) {
+	var direct []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tasks/user":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"data":[]}`))
+		case "/tasks/todo-old":
+			direct = append(direct, r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"data":{"id":"todo-old","type":"todo","text":"Taxes 2019","completed":true}}`))
+		default:
+			direct = append(direct, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"success":false,"error":"NotFound","message":"Task not found."}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+	ctx := context.Background()
+
+	task, err := client.GetTask(ctx, "todo-old")
+	require.NoError(t, err)
+	assert.Equal(t, "Taxes 2019", task.Text)
+
+	// The fetched task is cached like any other
+	_, err = client.GetTask(ctx, "todo-old")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/tasks/todo-old"}, direct)
+
+	// Only a 404 from the API means the task is gone
+	_, err = client.GetTask(ctx, "deleted")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, []string{"/tasks/todo-old", "/tasks/deleted"}, direct)
+}
+
+// TestClientGetTagConfirmsMisses validates that a tag created after the tag
+// list was cached is fetched directly instead of being reported as deleted
+func TestClientGetTagConfirmsMisses(t *testing.T) {
+	var direct []string
+	created := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tags":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"data":[{"id":"tag-1","name":"work"}]}`))
+		case "/tags/tag-2":
+			direct = append(direct, r.URL.Path)
+			if !created {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"success":false,"error":"NotFound","message":"Tag not found."}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"data":{"id":"tag-2","name":"home"}}`))
+		default:
+			direct = append(direct, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"success":false,"error":"NotFound","message":"Tag not found."}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+	ctx := context.Background()
+
+	_, err := client.GetTag(ctx, "tag-2")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Created elsewhere, e.g. in the app, while the list is still cached
+	created = true
+	tag, err := client.GetTag(ctx, "tag-2")
+	require.NoError(t, err)
+	assert.Equal(t, "home", tag.Name)
+
+	// The fetched tag is cached like any other
+	tags, err := client.ListTags(ctx)
+	require.NoError(t, err)
+	assert.Len(t, tags, 2)
+	_, err = client.GetTag(ctx, "tag-2")
+	require.NoError(t, err)
+
+	_, err = client.GetTag(ctx, "tag-gone")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, []string{"/tags/tag-2", "/tags/tag-2", "/tags/tag-gone"}, direct)
+}
@@ -0,0 +1,89 @@
+package client
+
+// ChecklistDiff describes the checklist endpoint calls needed to turn one
+// checklist into another.
+type ChecklistDiff struct {
+	// Result is the checklist expected after the calls are made. Items that
+	// keep their identity carry their ID and completion state; items that
+	// will be (re-)added have an empty ID.
+	Result []ChecklistItem
+
+	Deletes []string        // IDs of items to delete
+	Updates []ChecklistItem // kept items whose text changes
+	Adds    []ChecklistItem // items to append, in order
+}
+
+// DiffChecklist computes how to turn current into desired. Only the Text of
+// desired items is considered.
+//
+// Desired items are matched to current items by exact text first, then by
+// position (an edited item). Habitica has no endpoint to reorder checklist
+// items and always appends new ones, so once the desired order diverges from
+// the current one, every remaining item is deleted and re-added, carrying
+// over its completion state.
+func DiffChecklist(current, desired []ChecklistItem) ChecklistDiff {
+	matched := make([]int, len(desired))
+	used := make([]bool, len(current))
+	for i := range matched {
+		matched[i] = -1
+	}
+
+	for i, d := range desired {
+		for j, cur := range current {
+			if !used[j] && cur.Text == d.Text {
+				matched[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+
+	for i := range desired {
+		if matched[i] == -1 && i < len(current) && !used[i] {
+			matched[i] = i
+			used[i] = true
+		}
+	}
+
+	// Deleting never changes the relative order of the remaining items, so
+	// the longest run of desired items that are already in order can be
+	// kept; everything after it is re-added.
+	prefix := 0
+	for prefix < len(desired) && matched[prefix] != -1 && (prefix == 0 || matched[prefix] > matched[prefix-1]) {
+		prefix++
+	}
+
+	keep := make([]bool, len(current))
+	for _, j := range matched[:prefix] {
+		keep[j] = true
+	}
+
+	diff := ChecklistDiff{Result: make([]ChecklistItem, len(desired))}
+
+	for j, cur := range current {
+		if !keep[j] {
+			diff.Deletes = append(diff.Deletes, cur.ID)
+		}
+	}
+
+	for i, d := range desired {
+		if i < prefix {
+			cur := current[matched[i]]
+			item := ChecklistItem{ID: cur.ID, Text: d.Text, Completed: cur.Completed}
+			if cur.Text != d.Text {
+				diff.Updates = append(diff.Updates, item)
+			}
+			diff.Result[i] = item
+			continue
+		}
+
+		item := ChecklistItem{Text: d.Text}
+		if matched[i] != -1 {
+			item.Completed = current[matched[i]].Completed
+		}
+		diff.Adds = append(diff.Adds, item)
+		diff.Result[i] = item
+	}
+
+	return diff
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffChecklist(t *testing.T) {
+	current := []ChecklistItem{
+		{ID: "a", Text: "Brush teeth", Completed: true},
+		{ID: "b", Text: "Shower"},
+		{ID: "c", Text: "Stretch"},
+	}
+
+	tests := []struct {
+		name        string
+		desired     []string
+		wantResult  []ChecklistItem
+		wantDeletes []string
+		wantUpdates []ChecklistItem
+		wantAdds    []ChecklistItem
+	}{
+		{
+			name:       "unchanged",
+			desired:    []string{"Brush teeth", "Shower", "Stretch"},
+			wantResult: current,
+		},
+		{
+			name:       "append keeps existing IDs",
+			desired:    []string{"Brush teeth", "Shower", "Stretch", "Meditate"},
+			wantResult: append(append([]ChecklistItem{}, current...), ChecklistItem{Text: "Meditate"}),
+			wantAdds:   []ChecklistItem{{Text: "Meditate"}},
+		},
+		{
+			name:    "edit in place keeps ID and completion",
+			desired: []string{"Brush and floss", "Shower", "Stretch"},
+			wantResult: []ChecklistItem{
+				{ID: "a", Text: "Brush and floss", Completed: true},
+				{ID: "b", Text: "Shower"},
+				{ID: "c", Text: "Stretch"},
+			},
+			wantUpdates: []ChecklistItem{{ID: "a", Text: "Brush and floss", Completed: true}},
+		},
+		{
+			name:    "remove from the middle",
+			desired: []string{"Brush teeth", "Stretch"},
+			wantResult: []ChecklistItem{
+				{ID: "a", Text: "Brush teeth", Completed: true},
+				{ID: "c", Text: "Stretch"},
+			},
+			wantDeletes: []string{"b"},
+		},
+		{
+			name:    "insert in the middle re-adds the tail",
+			desired: []string{"Brush teeth", "Meditate", "Shower", "Stretch"},
+			wantResult: []ChecklistItem{
+				{ID: "a", Text: "Brush teeth", Completed: true},
+				{Text: "Meditate"},
+				{Text: "Shower"},
+				{Text: "Stretch"},
+			},
+			wantDeletes: []string{"b", "c"},
+			wantAdds:    []ChecklistItem{{Text: "Meditate"}, {Text: "Shower"}, {Text: "Stretch"}},
+		},
+		{
+			name:    "reorder preserves completion on re-add",
+			desired: []string{"Shower", "Brush teeth"},
+			wantResult: []ChecklistItem{
+				{ID: "b", Text: "Shower"},
+				{Text: "Brush teeth", Completed: true},
+			},
+			wantDeletes: []string{"a", "c"},
+			wantAdds:    []ChecklistItem{{Text: "Brush teeth", Completed: true}},
+		},
+		{
+			name:        "clear",
+			desired:     []string{},
+			wantResult:  []ChecklistItem{},
+			wantDeletes: []string{"a", "b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := make([]ChecklistItem, len(tt.desired))
+			for i, text := range tt.desired {
+				desired[i] = ChecklistItem{Text: text}
+			}
+
+			diff := DiffChecklist(current, desired)
+			assert.Equal(t, tt.wantResult, diff.Result)
+			assert.Equal(t, tt.wantDeletes, diff.Deletes)
+			assert.Equal(t, tt.wantUpdates, diff.Updates)
+			assert.Equal(t, tt.wantAdds, diff.Adds)
+		})
+	}
+}
+
+func TestClientSyncChecklist(t *testing.T) {
+	var calls []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		task := Task{ID: "daily-1", Type: "daily", Text: "Morning routine"}
+		switch r.Method {
+		case http.MethodDelete:
+			task.Checklist = []ChecklistItem{
+				{ID: "a", Text: "Brush teeth", Completed: true},
+				{ID: "c", Text: "Stretch"},
+			}
+		case http.MethodPost:
+			var body ChecklistItem
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "Meditate", body.Text)
+			task.Checklist = []ChecklistItem{
+				{ID: "a", Text: "Brush teeth", Completed: true},
+				{ID: "c", Text: "Stretch"},
+				{ID: "new", Text: "Meditate"},
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(APIResponse[Task]{Success: true, Data: task})
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+
+	task := &Task{
+		ID: "daily-1",
+		Checklist: []ChecklistItem{
+			{ID: "a", Text: "Brush teeth", Completed: true},
+			{ID: "b", Text: "Shower"},
+			{ID: "c", Text: "Stretch"},
+		},
+	}
+
+	updated, err := client.SyncChecklist(context.Background(), task, []ChecklistItem{
+		{Text: "Brush teeth"},
+		{Text: "Stretch"},
+		{Text: "Meditate"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"DELETE /tasks/daily-1/checklist/b",
+		"POST /tasks/daily-1/checklist",
+	}, calls)
+	assert.Equal(t, []ChecklistItem{
+		{ID: "a", Text: "Brush teeth", Completed: true},
+		{ID: "c", Text: "Stretch"},
+		{ID: "new", Text: "Meditate"},
+	}, updated.Checklist)
+}
+
+func TestClientSyncChecklistNoChanges(t *testing.T) {
+	callCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+
+	task := &Task{ID: "daily-1", Checklist: []ChecklistItem{{ID: "a", Text: "Brush teeth"}}}
+	updated, err := client.SyncChecklist(context.Background(), task, []ChecklistItem{{Text: "Brush teeth"}})
+	require.NoError(t, err)
+	assert.Same(t, task, updated)
+	assert.Equal(t, 0, callCount)
+}
@@ -4,12 +4,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"strconv"
+	"net/url"
+	"slices"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
@@ -17,6 +22,12 @@ const (
 	DefaultRateLimitBuf = 5
 	DefaultMaxRetries   = 5
 	DefaultRetryDelay   = 2 * time.Second
+	DefaultRetryJitter  = 0.2
+	DefaultTimeout      = 30 * time.Second
+
+	// Habitica allows 30 requests per minute per user
+	DefaultRequestsPerMinute     = 30
+	DefaultMaxConcurrentRequests = 4
 )
 
 // Client is an HTTP client for the Habitica API.
@@ -28,31 +39,36 @@ type Client struct {
 	httpClient *http.Client
 
 	// Rate limiting
-	rateLimitBuffer int
-	maxRetries      int
-	baseRetryDelay  time.Duration
+	limiter        *rateLimiter
+	maxRetries     int
+	baseRetryDelay time.Duration
+	retryJitter    float64
 
-	mu                 sync.Mutex
-	rateLimitRemaining int
-	rateLimitReset     time.Time
+	logBodies bool
 
 	// Caches for bulk fetching
-	taskCache   map[string]*Task
-	taskCacheMu sync.RWMutex
-	tagCache    map[string]*Tag
-	tagCacheMu  sync.RWMutex
+	cacheTTL     time.Duration
+	taskCaches   map[string]*entityCache[Task] // Keyed by task type, "" for all types
+	taskCachesMu sync.Mutex
+	tagCache     *entityCache[Tag]
 }
 
 // Config holds configuration for creating a new Client.
 type Config struct {
-	UserID          string
-	APIKey          string
-	ClientAuthorID  string
-	ClientAppName   string
-	RateLimitBuffer int
-	MaxRetries      int
-	BaseRetryDelay  time.Duration
-	BaseURL         string // Optional: override API base URL (for testing)
+	UserID                string
+	APIKey                string
+	ClientAuthorID        string
+	ClientAppName         string
+	RateLimitBuffer       int
+	RequestsPerMinute     int // Optional: quota shared by all concurrent operations
+	MaxConcurrentRequests int // Optional: maximum number of requests in flight
+	MaxRetries            int // Optional: negative disables retries
+	BaseRetryDelay        time.Duration
+	RequestTimeout        time.Duration // Optional: timeout for a single HTTP request
+	RetryJitter           float64       // Optional: random fraction added to each retry delay; negative disables
+	BaseURL               string        // Optional: override API base URL (self-hosted instances, testing)
+	LogBodies             bool          // Optional: include request and response bodies in debug logs
+	CacheTTL              time.Duration // Optional: how long bulk-fetched tasks and tags are reused
 }
 
 // New creates a new Habitica API client.
@@ -67,9 +83,21 @@ func New(cfg Config) *Client {
 		rateLimitBuffer = DefaultRateLimitBuf
 	}
 
+	requestsPerMinute := cfg.RequestsPerMinute
+	if requestsPerMinute <= 0 {
+		requestsPerMinute = DefaultRequestsPerMinute
+	}
+
+	maxConcurrent := cfg.MaxConcurrentRequests
+	if maxConcurrent <= 0 {
+		maxConcurrent = DefaultMaxConcurrentRequests
+	}
+
 	maxRetries := cfg.MaxRetries
-	if maxRetries <= 0 {
+	if maxRetries == 0 {
 		maxRetries = DefaultMaxRetries
+	} else if maxRetries < 0 {
+		maxRetries = 0
 	}
 
 	baseRetryDelay := cfg.BaseRetryDelay
@@ -77,39 +105,67 @@ func New(cfg Config) *Client {
 		baseRetryDelay = DefaultRetryDelay
 	}
 
-	baseURL := cfg.BaseURL
+	retryJitter := cfg.RetryJitter
+	if retryJitter == 0 {
+		retryJitter = DefaultRetryJitter
+	}
+
+	requestTimeout := cfg.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = DefaultTimeout
+	}
+
+	cacheTTL := cfg.CacheTTL
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultCacheTTL
+	}
+
+	baseURL := strings.TrimSuffix(cfg.BaseURL, "/")
 	if baseURL == "" {
 		baseURL = DefaultBaseURL
 	}
 
-	return &Client{
-		baseURL:            baseURL,
-		userID:             cfg.UserID,
-		apiKey:             cfg.APIKey,
-		clientID:           fmt.Sprintf("%s-%s", cfg.ClientAuthorID, appName),
-		httpClient:         &http.Client{Timeout: 30 * time.Second},
-		rateLimitBuffer:    rateLimitBuffer,
-		maxRetries:         maxRetries,
-		baseRetryDelay:     baseRetryDelay,
-		rateLimitRemaining: 30, // Start optimistic
-	}
+	c := &Client{
+		baseURL:        baseURL,
+		userID:         cfg.UserID,
+		apiKey:         cfg.APIKey,
+		clientID:       fmt.Sprintf("%s-%s", cfg.ClientAuthorID, appName),
+		httpClient:     &http.Client{Timeout: requestTimeout},
+		limiter:        newRateLimiter(requestsPerMinute, rateLimitBuffer, maxConcurrent),
+		maxRetries:     maxRetries,
+		baseRetryDelay: baseRetryDelay,
+		retryJitter:    retryJitter,
+		logBodies:      cfg.LogBodies,
+		cacheTTL:       cacheTTL,
+		taskCaches:     make(map[string]*entityCache[Task]),
+	}
+	c.tagCache = newEntityCache(cacheTTL, func(t *Tag) string { return t.ID }, c.GetAllTags)
+	return c
 }
 
 // do executes an HTTP request with rate limiting and retry logic.
 func (c *Client) do(ctx context.Context, method, path string, body any) ([]byte, error) {
-	var bodyReader io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("marshaling request body: %w", err)
 		}
-		bodyReader = bytes.NewReader(jsonBody)
 	}
 
+	ctx = c.logContext(ctx, method, path)
+
 	var lastErr error
+	var retryAfter time.Duration
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
 		if attempt > 0 {
-			delay := c.baseRetryDelay * time.Duration(1<<(attempt-1)) // Exponential backoff
+			delay := c.retryDelay(attempt, retryAfter)
+			tflog.Debug(ctx, "Retrying Habitica API request", map[string]any{
+				"attempt":    attempt + 1,
+				"wait_ms":    delay.Milliseconds(),
+				"last_error": lastErr.Error(),
+			})
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
@@ -117,23 +173,9 @@ func (c *Client) do(ctx context.Context, method, path string, body any) ([]byte,
 			}
 		}
 
-		// Check rate limit before making request
-		c.mu.Lock()
-		if c.rateLimitRemaining < c.rateLimitBuffer && time.Now().Before(c.rateLimitReset) {
-			waitTime := time.Until(c.rateLimitReset)
-			c.mu.Unlock()
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(waitTime):
-			}
-		} else {
-			c.mu.Unlock()
-		}
-
 		// Recreate body reader for retries
-		if body != nil {
-			jsonBody, _ := json.Marshal(body)
+		var bodyReader io.Reader
+		if jsonBody != nil {
 			bodyReader = bytes.NewReader(jsonBody)
 		}
 
@@ -147,58 +189,63 @@ func (c *Client) do(ctx context.Context, method, path string, body any) ([]byte,
 		req.Header.Set("x-api-key", c.apiKey)
 		req.Header.Set("x-client", c.clientID)
 
+		// Wait for a free slot and a token before making the request
+		release, err := c.limiter.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		c.logRequest(ctx, req, attempt+1, jsonBody)
+		start := time.Now()
+
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
+			release()
+			tflog.Debug(ctx, "Habitica API request failed", map[string]any{
+				"attempt": attempt + 1,
+				"error":   err.Error(),
+			})
+			// Only resend if the request could not have been applied twice
+			if ctx.Err() != nil || !isIdempotent(method) {
+				return nil, fmt.Errorf("executing request: %w", err)
+			}
 			lastErr = fmt.Errorf("executing request: %w", err)
+			retryAfter = 0
 			continue
 		}
 
 		// Update rate limit info
-		c.updateRateLimits(resp)
+		c.limiter.update(resp)
 
 		respBody, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
+		release()
+		c.logResponse(ctx, resp, attempt+1, time.Since(start), respBody)
 		if err != nil {
+			if ctx.Err() != nil || !isIdempotent(method) {
+				return nil, fmt.Errorf("reading response body: %w", err)
+			}
 			lastErr = fmt.Errorf("reading response body: %w", err)
+			retryAfter = 0
 			continue
 		}
 
-		// Handle rate limiting
-		if resp.StatusCode == http.StatusTooManyRequests {
-			lastErr = fmt.Errorf("rate limited (429)")
+		// Handle rate limiting and transient server errors
+		if shouldRetry(method, resp.StatusCode) {
+			lastErr = newAPIError(method, path, resp.StatusCode, respBody)
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
 			continue
 		}
 
 		// Handle other errors
 		if resp.StatusCode >= 400 {
-			var apiResp APIResponse[any]
-			if err := json.Unmarshal(respBody, &apiResp); err == nil && apiResp.Message != "" {
-				return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, apiResp.Message)
-			}
-			return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, string(respBody))
+			return nil, newAPIError(method, path, resp.StatusCode, respBody)
 		}
 
 		return respBody, nil
 	}
 
-	return nil, fmt.Errorf("max retries exceeded: %w", lastErr)
-}
-
-func (c *Client) updateRateLimits(resp *http.Response) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	if remaining := resp.Header.Get("X-RateLimit-Remaining"); remaining != "" {
-		if val, err := strconv.Atoi(remaining); err == nil {
-			c.rateLimitRemaining = val
-		}
-	}
-
-	if reset := resp.Header.Get("X-RateLimit-Reset"); reset != "" {
-		if ts, err := strconv.ParseInt(reset, 10, 64); err == nil {
-			c.rateLimitReset = time.Unix(ts, 0)
-		}
-	}
+	return nil, &RetryError{Attempts: c.maxRetries + 1, Err: lastErr}
 }
 
 // Get performs a GET request.
@@ -236,63 +283,43 @@ func (c *Client) CreateTag(ctx context.Context, name string) (*Tag, error) {
 		return nil, fmt.Errorf("unmarshaling response: %w", err)
 	}
 
-	c.invalidateTagCache()
+	c.tagCache.put(&apiResp.Data)
 	return &apiResp.Data, nil
 }
 
-// GetTag retrieves a tag by ID, using cache if available.
+// GetTag retrieves a tag by ID, using cache if available. Tags missing from
+// the cache are fetched directly, so a tag is only reported as not found when
+// the API says it does not exist.
 func (c *Client) GetTag(ctx context.Context, id string) (*Tag, error) {
-	// Check cache first
-	c.tagCacheMu.RLock()
-	if c.tagCache != nil {
-		if tag, ok := c.tagCache[id]; ok {
-			c.tagCacheMu.RUnlock()
-			return tag, nil
-		}
-	}
-	c.tagCacheMu.RUnlock()
-
-	// Cache miss - populate cache with all tags
-	if err := c.populateTagCache(ctx); err != nil {
+	tag, ok, err := c.tagCache.get(ctx, id)
+	if err != nil {
 		return nil, err
 	}
-
-	// Try cache again
-	c.tagCacheMu.RLock()
-	defer c.tagCacheMu.RUnlock()
-	if tag, ok := c.tagCache[id]; ok {
+	if ok {
 		return tag, nil
 	}
 
-	return nil, fmt.Errorf("tag not found: %s", id)
+	// The list may predate a tag created elsewhere since
+	return c.fetchTag(ctx, id)
 }
 
-// populateTagCache fetches all tags and caches them.
-func (c *Client) populateTagCache(ctx context.Context) error {
-	c.tagCacheMu.Lock()
-	defer c.tagCacheMu.Unlock()
-
-	// Already populated by another goroutine
-	if c.tagCache != nil {
-		return nil
+// fetchTag retrieves a tag by ID directly, bypassing the cache.
+func (c *Client) fetchTag(ctx context.Context, id string) (*Tag, error) {
+	resp, err := c.Get(ctx, "/tags/"+url.PathEscape(id))
+	if errors.Is(err, ErrNotFound) {
+		return nil, &NotFoundError{Kind: "tag", ID: id}
 	}
-
-	resp, err := c.Get(ctx, "/tags")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var apiResp APIResponse[[]Tag]
+	var apiResp APIResponse[Tag]
 	if err := json.Unmarshal(resp, &apiResp); err != nil {
-		return fmt.Errorf("unmarshaling response: %w", err)
-	}
-
-	c.tagCache = make(map[string]*Tag)
-	for i := range apiResp.Data {
-		c.tagCache[apiResp.Data[i].ID] = &apiResp.Data[i]
+		return nil, fmt.Errorf("unmarshaling response: %w", err)
 	}
 
-	return nil
+	c.tagCache.put(&apiResp.Data)
+	return &apiResp.Data, nil
 }
 
 // UpdateTag updates a tag.
@@ -308,29 +335,37 @@ func (c *Client) UpdateTag(ctx context.Context, id, name string) (*Tag, error) {
 		return nil, fmt.Errorf("unmarshaling response: %w", err)
 	}
 
-	c.invalidateTagCache()
+	c.tagCache.put(&apiResp.Data)
 	return &apiResp.Data, nil
 }
 
 // DeleteTag deletes a tag.
 func (c *Client) DeleteTag(ctx context.Context, id string) error {
 	_, err := c.Delete(ctx, "/tags/"+id)
-	if err == nil {
-		c.invalidateTagCache()
+	if err != nil {
+		return err
 	}
-	return err
-}
 
-func (c *Client) invalidateTagCache() {
-	c.tagCacheMu.Lock()
-	c.tagCache = nil
-	c.tagCacheMu.Unlock()
+	c.tagCache.remove(id)
+	// Habitica also removes the tag from every task
+	for _, cache := range c.allTaskCaches() {
+		cache.update(func(data *cacheData[Task]) {
+			for taskID, task := range data.items {
+				if slices.Contains(task.Tags, id) {
+					updated := *task
+					updated.Tags = slices.DeleteFunc(slices.Clone(task.Tags), func(t string) bool { return t == id })
+					data.items[taskID] = &updated
+				}
+			}
+		})
+	}
+	return nil
 }
 
 // Task operations
 
 // CreateTask creates a new task.
-func (c *Client) CreateTask(ctx context.Context, task *Task) (*Task, error) {
+func (c *Client) CreateTask(ctx context.Context, task *CreateTaskRequest) (*Task, error) {
 	resp, err := c.Post(ctx, "/tasks/user", task)
 	if err != nil {
 		return nil, err
@@ -341,68 +376,72 @@ func (c *Client) CreateTask(ctx context.Context, task *Task) (*Task, error) {
 		return nil, fmt.Errorf("unmarshaling response: %w", err)
 	}
 
-	c.invalidateTaskCache()
+	c.cacheTask(&apiResp.Data)
 	return &apiResp.Data, nil
 }
 
-// GetTask retrieves a task by ID, using cache if available.
+// GetTask retrieves a task by ID or alias, using cache if available. Tasks
+// missing from the cache are fetched directly, so a task is only reported as
+// not found when the API says it does not exist.
 func (c *Client) GetTask(ctx context.Context, id string) (*Task, error) {
-	// Check cache first
-	c.taskCacheMu.RLock()
-	if c.taskCache != nil {
-		if task, ok := c.taskCache[id]; ok {
-			c.taskCacheMu.RUnlock()
+	// Completed todos are only listed on their own
+	keys := []string{"", completedTodos}
+
+	for _, key := range keys {
+		task, ok, err := c.taskCache(key).get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
 			return task, nil
 		}
 	}
-	c.taskCacheMu.RUnlock()
 
-	// Cache miss - populate cache with all tasks
-	if err := c.populateTaskCache(ctx); err != nil {
-		return nil, err
-	}
-
-	// Try cache again
-	c.taskCacheMu.RLock()
-	defer c.taskCacheMu.RUnlock()
-	if task, ok := c.taskCache[id]; ok {
-		return task, nil
+	for _, key := range keys {
+		tasks, err := c.taskCache(key).list(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range tasks {
+			if tasks[i].Alias != nil && *tasks[i].Alias == id {
+				return &tasks[i], nil
+			}
+		}
 	}
 
-	return nil, fmt.Errorf("task not found: %s", id)
+	// The lists may be stale, and Habitica only lists the most recent
+	// completed todos, so a miss is confirmed with the API before the task
+	// is reported as deleted
+	return c.fetchTask(ctx, id)
 }
 
-// populateTaskCache fetches all tasks and caches them.
-func (c *Client) populateTaskCache(ctx context.Context) error {
-	c.taskCacheMu.Lock()
-	defer c.taskCacheMu.Unlock()
-
-	// Already populated by another goroutine
-	if c.taskCache != nil {
-		return nil
+// fetchTask retrieves a task by ID or alias directly, bypassing the cache.
+func (c *Client) fetchTask(ctx context.Context, id string) (*Task, error) {
+	resp, err := c.Get(ctx, "/tasks/"+url.PathEscape(id))
+	if errors.Is(err, ErrNotFound) {
+		return nil, &NotFoundError{Kind: "task", ID: id}
 	}
-
-	resp, err := c.Get(ctx, "/tasks/user")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var apiResp APIResponse[[]Task]
+	var apiResp APIResponse[Task]
 	if err := json.Unmarshal(resp, &apiResp); err != nil {
-		return fmt.Errorf("unmarshaling response: %w", err)
+		return nil, fmt.Errorf("unmarshaling response: %w", err)
 	}
 
-	c.taskCache = make(map[string]*Task)
-	for i := range apiResp.Data {
-		c.taskCache[apiResp.Data[i].ID] = &apiResp.Data[i]
-	}
+	c.cacheTask(&apiResp.Data)
+	return &apiResp.Data, nil
+}
 
-	return nil
+// UpdateTask updates a task, sending every field of task. See PatchTask to
+// send only changed fields.
+func (c *Client) UpdateTask(ctx context.Context, id string, task *UpdateTaskRequest) (*Task, error) {
+	return c.putTask(ctx, id, task)
 }
 
-// UpdateTask updates a task.
-func (c *Client) UpdateTask(ctx context.Context, id string, task *Task) (*Task, error) {
-	resp, err := c.Put(ctx, "/tasks/"+id, task)
+func (c *Client) putTask(ctx context.Context, id string, body any) (*Task, error) {
+	resp, err := c.Put(ctx, "/tasks/"+id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -412,28 +451,100 @@ func (c *Client) UpdateTask(ctx context.Context, id string, task *Task) (*Task,
 		return nil, fmt.Errorf("unmarshaling response: %w", err)
 	}
 
-	c.invalidateTaskCache()
+	c.cacheTask(&apiResp.Data)
 	return &apiResp.Data, nil
 }
 
 // DeleteTask deletes a task.
 func (c *Client) DeleteTask(ctx context.Context, id string) error {
 	_, err := c.Delete(ctx, "/tasks/"+id)
-	if err == nil {
-		c.invalidateTaskCache()
+	if err != nil {
+		return err
 	}
-	return err
-}
 
-func (c *Client) invalidateTaskCache() {
-	c.taskCacheMu.Lock()
-	c.taskCache = nil
-	c.taskCacheMu.Unlock()
+	for _, cache := range c.allTaskCaches() {
+		cache.remove(id)
+	}
+	return nil
 }
 
-// GetAllTasks retrieves all tasks for the user.
+// GetAllTasks retrieves all tasks for the user, except completed todos.
 func (c *Client) GetAllTasks(ctx context.Context) ([]Task, error) {
-	resp, err := c.Get(ctx, "/tasks/user")
+	return c.ListTasks(ctx, ListTasksOptions{})
+}
+
+// completedTodos is the task cache key for completed todos, which Habitica
+// leaves out of every other task list. Habitica only lists the most recent
+// completed todos, so this list never proves that a todo was deleted.
+const completedTodos = "completedTodo"
+
+// taskTypeQueries maps task types to the type filter of GET /tasks/user.
+var taskTypeQueries = map[string]string{
+	"habit":        "habits",
+	"daily":        "dailys",
+	"todo":         "todos",
+	"reward":       "rewards",
+	completedTodos: "completedTodos",
+}
+
+// ListTasksOptions filters the tasks returned by ListTasks.
+type ListTasksOptions struct {
+	// Type limits the result to "habit", "daily", "todo" or "reward" tasks.
+	// Empty returns all types.
+	Type string
+	// IncludeCompletedTodos adds completed todos, which the API otherwise
+	// leaves out. Only the most recently completed todos are listed.
+	IncludeCompletedTodos bool
+	// DueDate computes the isDue and nextDue fields of dailies for this date
+	// instead of today. Results for a due date are not cached.
+	DueDate time.Time
+}
+
+// ListTasks retrieves the user's tasks, fetching only the requested type.
+// Each type is cached separately.
+func (c *Client) ListTasks(ctx context.Context, opts ListTasksOptions) ([]Task, error) {
+	if _, ok := taskTypeQueries[opts.Type]; opts.Type != "" && (!ok || opts.Type == completedTodos) {
+		return nil, fmt.Errorf("unknown task type: %q", opts.Type)
+	}
+
+	var tasks []Task
+	var err error
+	if opts.DueDate.IsZero() {
+		tasks, err = c.taskCache(opts.Type).list(ctx)
+	} else {
+		tasks, err = c.fetchTasks(ctx, opts.Type, opts.DueDate)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.IncludeCompletedTodos && (opts.Type == "" || opts.Type == "todo") {
+		completed, err := c.taskCache(completedTodos).list(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, completed...)
+	}
+
+	return tasks, nil
+}
+
+// fetchTasks fetches the tasks of one type, or all types if empty.
+func (c *Client) fetchTasks(ctx context.Context, taskType string, dueDate time.Time) ([]Task, error) {
+	query := url.Values{}
+	if taskType != "" {
+		query.Set("type", taskTypeQueries[taskType])
+	}
+	if !dueDate.IsZero() {
+		query.Set("dueDate", dueDate.Format("2006-01-02"))
+	}
+
+	path := "/tasks/user"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	resp, err := c.Get(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -446,6 +557,62 @@ func (c *Client) GetAllTasks(ctx context.Context) ([]Task, error) {
 	return apiResp.Data, nil
 }
 
+// taskCache returns the cache for a task type, creating it on first use.
+func (c *Client) taskCache(taskType string) *entityCache[Task] {
+	c.taskCachesMu.Lock()
+	defer c.taskCachesMu.Unlock()
+
+	cache, ok := c.taskCaches[taskType]
+	if !ok {
+		cache = newEntityCache(c.cacheTTL, func(t *Task) string { return t.ID }, func(ctx context.Context) ([]Task, error) {
+			return c.fetchTasks(ctx, taskType, time.Time{})
+		})
+		c.taskCaches[taskType] = cache
+	}
+	return cache
+}
+
+func (c *Client) allTaskCaches() []*entityCache[Task] {
+	c.taskCachesMu.Lock()
+	defer c.taskCachesMu.Unlock()
+
+	caches := make([]*entityCache[Task], 0, len(c.taskCaches))
+	for _, cache := range c.taskCaches {
+		caches = append(caches, cache)
+	}
+	return caches
+}
+
+// cacheTask writes a task returned by the API through to every cache that
+// lists it, and drops it from those that no longer do, e.g. a todo that was
+// just completed.
+func (c *Client) cacheTask(task *Task) {
+	completed := task.Type == "todo" && task.Completed
+
+	c.taskCachesMu.Lock()
+	defer c.taskCachesMu.Unlock()
+
+	for key, cache := range c.taskCaches {
+		var listed bool
+		if key == completedTodos {
+			listed = completed
+		} else {
+			listed = !completed && (key == "" || key == task.Type)
+		}
+
+		if listed {
+			cache.put(task)
+		} else {
+			cache.remove(task.ID)
+		}
+	}
+}
+
+// ListTags retrieves all tags for the user, using cache if available.
+func (c *Client) ListTags(ctx context.Context) ([]Tag, error) {
+	return c.tagCache.list(ctx)
+}
+
 // GetAllTags retrieves all tags for the user.
 func (c *Client) GetAllTags(ctx context.Context) ([]Tag, error) {
 	resp, err := c.Get(ctx, "/tags")
@@ -506,7 +673,7 @@ func (c *Client) GetWebhook(ctx context.Context, id string) (*Webhook, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("webhook not found: %s", id)
+	return nil, &NotFoundError{Kind: "webhook", ID: id}
 }
 
 // UpdateWebhook updates a webhook.
@@ -529,3 +696,89 @@ func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
 	_, err := c.Delete(ctx, "/user/webhook/"+id)
 	return err
 }
+
+// Checklist operations
+
+// AddChecklistItem appends an item to a task's checklist and returns the updated task.
+func (c *Client) AddChecklistItem(ctx context.Context, taskID string, item *ChecklistItem) (*Task, error) {
+	body := map[string]any{"text": item.Text, "completed": item.Completed}
+	resp, err := c.Post(ctx, "/tasks/"+taskID+"/checklist", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResp APIResponse[Task]
+	if err := json.Unmarshal(resp, &apiResp); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %w", err)
+	}
+
+	c.cacheTask(&apiResp.Data)
+	return &apiResp.Data, nil
+}
+
+// UpdateChecklistItem updates the text of an existing checklist item and returns the updated task.
+func (c *Client) UpdateChecklistItem(ctx context.Context, taskID string, item *ChecklistItem) (*Task, error) {
+	body := map[string]any{"text": item.Text, "completed": item.Completed}
+	resp, err := c.Put(ctx, "/tasks/"+taskID+"/checklist/"+item.ID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResp APIResponse[Task]
+	if err := json.Unmarshal(resp, &apiResp); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %w", err)
+	}
+
+	c.cacheTask(&apiResp.Data)
+	return &apiResp.Data, nil
+}
+
+// DeleteChecklistItem removes an item from a task's checklist and returns the updated task.
+func (c *Client) DeleteChecklistItem(ctx context.Context, taskID, itemID string) (*Task, error) {
+	resp, err := c.Delete(ctx, "/tasks/"+taskID+"/checklist/"+itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResp APIResponse[Task]
+	if err := json.Unmarshal(resp, &apiResp); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %w", err)
+	}
+
+	c.cacheTask(&apiResp.Data)
+	return &apiResp.Data, nil
+}
+
+// SyncChecklist makes the checklist of task match desired, using the
+// checklist endpoints so that surviving items keep their IDs and completion
+// state. Only the Text of desired items is used. It returns the updated task.
+func (c *Client) SyncChecklist(ctx context.Context, task *Task, desired []ChecklistItem) (*Task, error) {
+	diff := DiffChecklist(task.Checklist, desired)
+
+	result := task
+	for _, id := range diff.Deletes {
+		updated, err := c.DeleteChecklistItem(ctx, task.ID, id)
+		if err != nil {
+			return nil, fmt.Errorf("deleting checklist item %s: %w", id, err)
+		}
+		result = updated
+	}
+
+	for i := range diff.Updates {
+		updated, err := c.UpdateChecklistItem(ctx, task.ID, &diff.Updates[i])
+		if err != nil {
+			return nil, fmt.Errorf("updating checklist item %s: %w", diff.Updates[i].ID, err)
+		}
+		result = updated
+	}
+
+	for i := range diff.Adds {
+		updated, err := c.AddChecklistItem(ctx, task.ID, &diff.Adds[i])
+		if err != nil {
+			return nil, fmt.Errorf("adding checklist item %q: %w", diff.Adds[i].Text, err)
+		}
+		result = updated
+	}
+
+	return result, nil
+}
@@ -210,17 +210,32 @@ func TestClientTagCachePopulation(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "tag-2", tag2.ID)
 	assert.Equal(t, 1, callCount) // Still 1, used cache
+
+	// ListTags shares the same cache
+	tags, err := client.ListTags(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Tag{{ID: "tag-1", Name: "work"}, {ID: "tag-2", Name: "exercise"}}, tags)
+	assert.Equal(t, 1, callCount)
 }
 
-func TestClientCacheInvalidation(t *testing.T) {
+func TestClientCacheWriteThrough(t *testing.T) {
 	callCount := 0
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		callCount++
+		if r.Method == http.MethodGet && r.URL.Path != "/tasks/user" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"success":false,"error":"NotFound","message":"Task not found."}`))
+			return
+		}
 		w.WriteHeader(http.StatusOK)
 
 		switch r.Method {
 		case http.MethodGet:
+			if r.URL.Query().Get("type") == "completedTodos" {
+				w.Write([]byte(`{"success":true,"data":[]}`))
+				return
+			}
 			w.Write([]byte(`{"success":true,"data":[{"id":"task-1","type":"habit","text":"Exercise"}]}`))
 		case http.MethodPost:
 			w.Write([]byte(`{"success":true,"data":{"id":"task-new","type":"habit","text":"New Task"}}`))
@@ -244,23 +259,33 @@ func TestClientCacheInvalidation(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1, callCount)
 
-	// Create should invalidate cache
-	_, err = client.CreateTask(context.Background(), &Task{Type: "habit", Text: "New"})
+	// Create adds the new task to the cache
+	_, err = client.CreateTask(context.Background(), &CreateTaskRequest{Type: "habit", UpdateTaskRequest: UpdateTaskRequest{Text: "New"}})
 	require.NoError(t, err)
 	assert.Equal(t, 2, callCount)
 
-	// Next GetTask should re-fetch (cache was invalidated)
-	_, err = client.GetTask(context.Background(), "task-1")
+	created, err := client.GetTask(context.Background(), "task-new")
+	require.NoError(t, err)
+	assert.Equal(t, "New Task", created.Text)
+	assert.Equal(t, 2, callCount) // No re-fetch
+
+	// Update replaces the cached task
+	_, err = client.UpdateTask(context.Background(), "task-1", &UpdateTaskRequest{Text: "Updated"})
 	require.NoError(t, err)
-	assert.Equal(t, 3, callCount) // Cache miss, new fetch
 
-	// Update should also invalidate
-	_, err = client.UpdateTask(context.Background(), "task-1", &Task{Text: "Updated"})
+	updated, err := client.GetTask(context.Background(), "task-1")
 	require.NoError(t, err)
+	assert.Equal(t, "Updated", updated.Text)
+	assert.Equal(t, 3, callCount)
 
-	// Delete should also invalidate
+	// Delete drops it; the miss also checks completed todos and is
+	// confirmed with the API
 	err = client.DeleteTask(context.Background(), "task-1")
 	require.NoError(t, err)
+
+	_, err = client.GetTask(context.Background(), "task-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, 6, callCount)
 }
 
 func TestClientJSONMarshaling(t *testing.T) {
@@ -286,11 +311,13 @@ func TestClientJSONMarshaling(t *testing.T) {
 	})
 
 	upPtr := true
-	_, err := client.CreateTask(context.Background(), &Task{
-		Type:     "habit",
-		Text:     "Test Task",
-		Priority: 1.5,
-		Up:       &upPtr,
+	_, err := client.CreateTask(context.Background(), &CreateTaskRequest{
+		Type: "habit",
+		UpdateTaskRequest: UpdateTaskRequest{
+			Text:     "Test Task",
+			Priority: 1.5,
+			Up:       &upPtr,
+		},
 	})
 	require.NoError(t, err)
 
@@ -342,3 +369,38 @@ func TestClientErrorResponseParsing(t *testing.T) {
 	// Error should contain either the status code or message
 	assert.Contains(t, err.Error(), "400")
 }
+
+func TestClientNotFoundErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tasks/user", "/tags":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"data":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"success":false,"error":"NotFound","message":"Task not found."}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+
+	_, err := client.GetTask(context.Background(), "missing-task")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "missing-task")
+
+	_, err = client.GetTag(context.Background(), "missing-tag")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// A 404 from the API itself is also reported as not found
+	err = client.DeleteTask(context.Background(), "missing-task")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "404")
+}
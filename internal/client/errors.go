@@ -0,0 +1,136 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors for use with errors.Is. Errors returned by the client wrap
+// or match these rather than being compared directly.
+var (
+	// ErrNotFound is returned when a task, tag or webhook does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized is returned when the API rejects the credentials (401).
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrConflict is returned when a request conflicts with existing data (409).
+	ErrConflict = errors.New("conflict")
+	// ErrValidation is returned when the API rejects a request as invalid (400).
+	ErrValidation = errors.New("validation failed")
+	// ErrRateLimited is returned when the API responds with 429.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrRetriesExhausted is returned when a request still fails after all retries.
+	ErrRetriesExhausted = errors.New("max retries exceeded")
+)
+
+// APIError is an error response from the Habitica API.
+type APIError struct {
+	StatusCode int
+	// Code is Habitica's error name, e.g. "NotFound" or "BadRequest".
+	Code    string
+	Message string
+	Method  string
+	Path    string
+	// ValidationErrors lists the offending fields of a 400 response, if any.
+	ValidationErrors []ValidationError
+}
+
+// ValidationError describes a single rejected field of a request.
+type ValidationError struct {
+	Message string `json:"message"`
+	Param   string `json:"param,omitempty"`
+}
+
+func newAPIError(method, path string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Path:       path,
+		Message:    string(body),
+	}
+
+	var envelope struct {
+		Error   string            `json:"error"`
+		Message string            `json:"message"`
+		Errors  []ValidationError `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		apiErr.Code = envelope.Error
+		apiErr.ValidationErrors = envelope.Errors
+		if envelope.Message != "" {
+			apiErr.Message = envelope.Message
+		}
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API error (%d) for %s %s: %s", e.StatusCode, e.Method, e.Path, e.Message)
+	if len(e.ValidationErrors) > 0 {
+		details := make([]string, len(e.ValidationErrors))
+		for i, v := range e.ValidationErrors {
+			if v.Param != "" {
+				details[i] = v.Param + ": " + v.Message
+			} else {
+				details[i] = v.Message
+			}
+		}
+		msg += " (" + strings.Join(details, "; ") + ")"
+	}
+	return msg
+}
+
+// Is matches the sentinel error corresponding to the status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// NotFoundError reports that a task, tag or webhook does not exist, either
+// because the API answered 404 or because it was not among those listed. It
+// matches ErrNotFound.
+type NotFoundError struct {
+	Kind string // "task", "tag" or "webhook"
+	ID   string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found: %s", e.Kind, e.ID)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// RetryError is returned when a request fails on every attempt. It matches
+// ErrRetriesExhausted and unwraps to the error of the last attempt.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%s after %d attempts: %v", ErrRetriesExhausted, e.Attempts, e.Err)
+}
+
+func (e *RetryError) Is(target error) bool {
+	return target == ErrRetriesExhausted
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
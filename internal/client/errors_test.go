@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorSentinels(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		sentinel error
+		code     string
+	}{
+		{"unauthorized", http.StatusUnauthorized, `{"success":false,"error":"NotAuthorized","message":"Missing authentication headers."}`, ErrUnauthorized, "NotAuthorized"},
+		{"not found", http.StatusNotFound, `{"success":false,"error":"NotFound","message":"Task not found."}`, ErrNotFound, "NotFound"},
+		{"conflict", http.StatusConflict, `{"success":false,"error":"Conflict","message":"Alias already in use."}`, ErrConflict, "Conflict"},
+		{"validation", http.StatusBadRequest, `{"success":false,"error":"BadRequest","message":"Task validation failed"}`, ErrValidation, "BadRequest"},
+	}
+
+	sentinels := []error{ErrUnauthorized, ErrNotFound, ErrConflict, ErrValidation, ErrRateLimited, ErrRetriesExhausted}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := New(Config{
+				UserID:         "test-user",
+				APIKey:         "test-key",
+				ClientAuthorID: "test-author",
+				BaseURL:        server.URL,
+			})
+
+			_, err := client.Post(context.Background(), "/tasks/user", map[string]string{"text": "x"})
+			require.Error(t, err)
+
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.code, apiErr.Code)
+			assert.Equal(t, http.MethodPost, apiErr.Method)
+			assert.Equal(t, "/tasks/user", apiErr.Path)
+
+			for _, s := range sentinels {
+				assert.Equal(t, s == tt.sentinel, errors.Is(err, s), "errors.Is(err, %v)", s)
+			}
+		})
+	}
+}
+
+func TestAPIErrorValidationDetails(t *testing.T) {
+	body := []byte(`{"success":false,"error":"BadRequest","message":"Invalid request parameters.","errors":[{"message":"Task text is required.","param":"text"}]}`)
+
+	err := newAPIError(http.MethodPost, "/tasks/user", http.StatusBadRequest, body)
+
+	require.Len(t, err.ValidationErrors, 1)
+	assert.Equal(t, "text", err.ValidationErrors[0].Param)
+	assert.Equal(t, "API error (400) for POST /tasks/user: Invalid request parameters. (text: Task text is required.)", err.Error())
+}
+
+func TestAPIErrorNonJSONBody(t *testing.T) {
+	err := newAPIError(http.MethodGet, "/tags", http.StatusBadGateway, []byte("Bad Gateway"))
+
+	assert.Empty(t, err.Code)
+	assert.Equal(t, "Bad Gateway", err.Message)
+	assert.Contains(t, err.Error(), "502")
+}
+
+func TestRetriesExhaustedError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"success":false,"error":"TooManyRequests","message":"Too many requests."}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		MaxRetries:     2,
+		BaseRetryDelay: time.Millisecond,
+		BaseURL:        server.URL,
+	})
+
+	_, err := client.Get(context.Background(), "/tasks/user")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.ErrorIs(t, err, ErrRateLimited)
+
+	var retryErr *RetryError
+	require.ErrorAs(t, err, &retryErr)
+	assert.Equal(t, 3, retryErr.Attempts)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedHeaders are request headers whose values are never logged.
+var redactedHeaders = map[string]bool{
+	"X-Api-Key": true,
+}
+
+// apiTokenField matches the API token as it appears in /user response bodies.
+var apiTokenField = regexp.MustCompile(`"apiToken"\s*:\s*"[^"]*"`)
+
+// logContext returns a context whose log entries carry the request method
+// and path and never contain the API token.
+func (c *Client) logContext(ctx context.Context, method, path string) context.Context {
+	ctx = tflog.SetField(ctx, "http_method", method)
+	ctx = tflog.SetField(ctx, "http_path", path)
+	if c.apiKey != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, c.apiKey)
+		ctx = tflog.MaskMessageStrings(ctx, c.apiKey)
+	}
+	return tflog.MaskAllFieldValuesRegexes(ctx, apiTokenField)
+}
+
+func (c *Client) logRequest(ctx context.Context, req *http.Request, attempt int, body []byte) {
+	fields := map[string]any{
+		"attempt":         attempt,
+		"request_headers": redactHeaders(req.Header),
+	}
+	if c.logBodies && body != nil {
+		fields["request_body"] = string(body)
+	}
+	tflog.Debug(ctx, "Sending Habitica API request", fields)
+}
+
+func (c *Client) logResponse(ctx context.Context, resp *http.Response, attempt int, elapsed time.Duration, body []byte) {
+	fields := map[string]any{
+		"attempt":     attempt,
+		"http_status": resp.StatusCode,
+		"duration_ms": elapsed.Milliseconds(),
+	}
+	for field, header := range map[string]string{
+		"rate_limit_limit":     "X-RateLimit-Limit",
+		"rate_limit_remaining": "X-RateLimit-Remaining",
+		"rate_limit_reset":     "X-RateLimit-Reset",
+		"retry_after":          "Retry-After",
+	} {
+		if value := resp.Header.Get(header); value != "" {
+			fields[field] = value
+		}
+	}
+	if c.logBodies {
+		fields["response_body"] = string(body)
+	}
+	tflog.Debug(ctx, "Received Habitica API response", fields)
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name := range header {
+		if redactedHeaders[http.CanonicalHeaderKey(name)] {
+			redacted[name] = "REDACTED"
+			continue
+		}
+		redacted[name] = header.Get(name)
+	}
+	return redacted
+}
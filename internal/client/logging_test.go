@@ -0,0 +1,78 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "29")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":{"id":"user-1","apiToken":"secret-token-456"}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		logBodies bool
+	}{
+		{"without bodies", false},
+		{"with bodies", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+
+			client := New(Config{
+				UserID:         "test-user",
+				APIKey:         "secret-token-456",
+				ClientAuthorID: "test-author",
+				BaseURL:        server.URL,
+				LogBodies:      tt.logBodies,
+			})
+
+			_, err := client.Put(ctx, "/user", map[string]string{"preferences.timezoneOffset": "0"})
+			require.NoError(t, err)
+
+			logged := output.String()
+			assert.NotContains(t, logged, "secret-token-456")
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+
+			request, response := entries[0], entries[1]
+			assert.Equal(t, "Sending Habitica API request", request["@message"])
+			assert.Equal(t, "PUT", request["http_method"])
+			assert.Equal(t, "/user", request["http_path"])
+			assert.Equal(t, float64(1), request["attempt"])
+
+			assert.Equal(t, "Received Habitica API response", response["@message"])
+			assert.Equal(t, float64(http.StatusOK), response["http_status"])
+			assert.Equal(t, "29", response["rate_limit_remaining"])
+
+			_, hasBody := response["response_body"]
+			assert.Equal(t, tt.logBodies, hasBody)
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("x-api-user", "test-user")
+	header.Set("x-api-key", "secret")
+
+	redacted := redactHeaders(header)
+	assert.Equal(t, "test-user", redacted["X-Api-User"])
+	assert.Equal(t, "REDACTED", redacted["X-Api-Key"])
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+// PatchTask updates only the fields that differ between prior and planned,
+// typically built from a resource's state and plan. Fields the caller does
+// not manage are equal in both and so are left as they are in Habitica,
+// whoever last changed them. If nothing changed, no request is made.
+func (c *Client) PatchTask(ctx context.Context, id string, prior, planned *UpdateTaskRequest) (*Task, error) {
+	changes := taskChanges(prior, planned)
+	if len(changes) == 0 {
+		return c.GetTask(ctx, id)
+	}
+	return c.putTask(ctx, id, changes)
+}
+
+// taskChanges returns the JSON fields of planned that differ from prior.
+// Changed fields are sent even when empty, so that clearing a field in the
+// plan clears it in Habitica. Nil and empty slices are treated as equal.
+// Only exported, named JSON fields are compared; embedded and unexported
+// fields are skipped.
+func taskChanges[T any](prior, planned *T) map[string]any {
+	changes := make(map[string]any)
+
+	before := reflect.ValueOf(prior).Elem()
+	after := reflect.ValueOf(planned).Elem()
+	fields := before.Type()
+
+	for i := range fields.NumField() {
+		field := fields.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.Anonymous || !field.IsExported() || name == "" || name == "-" {
+			continue
+		}
+
+		a, b := before.Field(i), after.Field(i)
+		if a.Kind() == reflect.Slice && a.Len() == 0 && b.Len() == 0 {
+			continue
+		}
+		if reflect.DeepEqual(a.Interface(), b.Interface()) {
+			continue
+		}
+
+		// Send a cleared list as [] rather than null
+		if b.Kind() == reflect.Slice && b.IsNil() {
+			b = reflect.MakeSlice(b.Type(), 0, 0)
+		}
+		changes[name] = b.Interface()
+	}
+
+	return changes
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskChanges(t *testing.T) {
+	up := true
+	down := false
+	empty := ""
+
+	prior := &UpdateTaskRequest{
+		Text:      "Exercise",
+		Notes:     "Every morning",
+		Tags:      []string{"tag-1"},
+		Priority:  1,
+		Attribute: "str",
+		Up:        &up,
+		Down:      &down,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*UpdateTaskRequest)
+		want   map[string]any
+	}{
+		{
+			name:   "unchanged",
+			modify: func(*UpdateTaskRequest) {},
+			want:   map[string]any{},
+		},
+		{
+			name:   "changed text only",
+			modify: func(t *UpdateTaskRequest) { t.Text = "Run" },
+			want:   map[string]any{"text": "Run"},
+		},
+		{
+			name:   "cleared notes are sent empty",
+			modify: func(t *UpdateTaskRequest) { t.Notes = "" },
+			want:   map[string]any{"notes": ""},
+		},
+		{
+			name:   "cleared tags are sent as an empty list",
+			modify: func(t *UpdateTaskRequest) { t.Tags = nil },
+			want:   map[string]any{"tags": []any{}},
+		},
+		{
+			name:   "removed alias is sent empty",
+			modify: func(t *UpdateTaskRequest) { t.Alias = &empty },
+			want:   map[string]any{"alias": ""},
+		},
+		{
+			name: "toggled down",
+			modify: func(t *UpdateTaskRequest) {
+				d := true
+				t.Down = &d
+			},
+			want: map[string]any{"down": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planned := *prior
+			planned.Tags = append([]string(nil), prior.Tags...)
+			tt.modify(&planned)
+
+			// Compare as JSON, which is what is sent
+			body, err := json.Marshal(taskChanges(prior, &planned))
+			require.NoError(t, err)
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(body, &got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskChangesEmptyListsAreEqual(t *testing.T) {
+	prior := &UpdateTaskRequest{Text: "Stretch", DaysOfMonth: nil}
+	planned := &UpdateTaskRequest{Text: "Stretch", DaysOfMonth: []int{}}
+
+	assert.Empty(t, taskChanges(prior, planned))
+}
+
+func TestTaskChangesSkipsEmbeddedAndUnexportedFields(t *testing.T) {
+	type embedded struct {
+		Notes string `json:"notes"`
+	}
+	type request struct {
+		embedded
+		Text    string `json:"text"`
+		secret  string
+		Ignored string `json:"-"`
+		Untyped string
+	}
+
+	prior := &request{embedded: embedded{Notes: "a"}, Text: "Stretch", secret: "a", Ignored: "a", Untyped: "a"}
+	planned := &request{embedded: embedded{Notes: "b"}, Text: "Run", secret: "b", Ignored: "b", Untyped: "b"}
+
+	assert.Equal(t, map[string]any{"text": "Run"}, taskChanges(prior, planned))
+}
+
+func TestClientPatchTask(t *testing.T) {
+	var methods []string
+	var putBody map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.WriteHeader(http.StatusOK)
+
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("type") == "completedTodos" {
+				w.Write([]byte(`{"success":true,"data":[]}`))
+				return
+			}
+			w.Write([]byte(`{"success":true,"data":[{"id":"task-1","type":"daily","text":"Stretch","streak":12}]}`))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &putBody))
+			w.Write([]byte(`{"success":true,"data":{"id":"task-1","type":"daily","text":"Stretch well","streak":12}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+
+	prior := &UpdateTaskRequest{Text: "Stretch", Frequency: "weekly", EveryX: 1}
+
+	// No changes reads the task instead of writing it
+	task, err := client.PatchTask(context.Background(), "task-1", prior, &UpdateTaskRequest{Text: "Stretch", Frequency: "weekly", EveryX: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "Stretch", task.Text)
+	assert.NotContains(t, methods, http.MethodPut)
+
+	// Only the changed field is sent
+	task, err = client.PatchTask(context.Background(), "task-1", prior, &UpdateTaskRequest{Text: "Stretch well", Frequency: "weekly", EveryX: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "Stretch well", task.Text)
+	assert.Equal(t, map[string]any{"text": "Stretch well"}, putBody)
+}
@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// rateLimitWindow is the period over which Habitica counts requests.
+const rateLimitWindow = time.Minute
+
+// rateLimiter is shared by all requests made through a Client. A semaphore
+// caps the number of requests in flight, and a token bucket sized to the
+// API quota decides when the next request may start.
+//
+// While the server has reported when its window resets, the bucket is only
+// refilled at that time. Otherwise it refills continuously at the configured
+// rate. Responses reconcile the bucket with X-RateLimit-Remaining so other
+// clients using the same account are accounted for.
+type rateLimiter struct {
+	sem chan struct{}
+
+	mu       sync.Mutex
+	capacity int
+	buffer   int
+	tokens   float64
+	last     time.Time // last continuous refill
+	reset    time.Time // server-reported window reset, zero if unknown
+	now      func() time.Time
+}
+
+func newRateLimiter(requestsPerMinute, buffer, maxInFlight int) *rateLimiter {
+	return &rateLimiter{
+		sem:      make(chan struct{}, maxInFlight),
+		capacity: requestsPerMinute,
+		buffer:   buffer,
+		tokens:   float64(requestsPerMinute), // Start optimistic
+		last:     time.Now(),
+		now:      time.Now,
+	}
+}
+
+// acquire blocks until a request may be sent. The returned function must be
+// called once the response has been read.
+func (l *rateLimiter) acquire(ctx context.Context) (func(), error) {
+	select {
+	case l.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	release := func() { <-l.sem }
+
+	for {
+		wait := l.take()
+		if wait == 0 {
+			return release, nil
+		}
+
+		state := l.state()
+		tflog.Debug(ctx, "Waiting for Habitica rate limit", map[string]any{
+			"wait_ms":              wait.Milliseconds(),
+			"rate_limit_remaining": state.Remaining,
+			"rate_limit_reset":     state.Reset,
+		})
+
+		select {
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// take consumes a token and returns zero, or returns how long to wait before
+// trying again.
+func (l *rateLimiter) take() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	// Keep the buffer in reserve for other clients on the same account
+	if l.tokens >= float64(l.buffer+1) {
+		l.tokens--
+		return 0
+	}
+
+	if !l.reset.IsZero() {
+		return l.reset.Sub(now)
+	}
+
+	missing := float64(l.buffer+1) - l.tokens
+	return time.Duration(missing * float64(rateLimitWindow) / float64(l.capacity))
+}
+
+func (l *rateLimiter) refill(now time.Time) {
+	if !l.reset.IsZero() {
+		if now.Before(l.reset) {
+			l.last = now
+			return
+		}
+		l.reset = time.Time{}
+		l.tokens = float64(l.capacity)
+		l.last = now
+		return
+	}
+
+	elapsed := now.Sub(l.last)
+	l.last = now
+	l.tokens += elapsed.Seconds() * float64(l.capacity) / rateLimitWindow.Seconds()
+	if l.tokens > float64(l.capacity) {
+		l.tokens = float64(l.capacity)
+	}
+}
+
+// update reconciles the bucket with the rate limit headers of a response.
+func (l *rateLimiter) update(resp *http.Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	if limit := resp.Header.Get("X-RateLimit-Limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil && val > 0 && val < l.capacity {
+			l.capacity = val
+			// A buffer as large as the quota would block every request, so
+			// always leave at least one per window for this client
+			l.buffer = min(l.buffer, val-1)
+			l.tokens = min(l.tokens, float64(val))
+		}
+	}
+
+	reset, _ := parseRateLimitReset(resp.Header.Get("X-RateLimit-Reset"))
+	newWindow := reset.After(now) && reset.After(l.reset)
+	if reset.After(now) {
+		l.reset = reset
+	}
+
+	if header := resp.Header.Get("X-RateLimit-Remaining"); header != "" {
+		if val, err := strconv.Atoi(header); err == nil {
+			// The server count lags behind other requests still in flight, so
+			// within a window only ever lower the local count
+			if newWindow {
+				others := max(len(l.sem)-1, 0)
+				l.tokens = max(float64(val-others), 0)
+			} else if float64(val) < l.tokens {
+				l.tokens = float64(val)
+			}
+		}
+	}
+
+	// Hold back every request, not just the one being retried, until the
+	// server is ready again
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if wait := parseRetryAfter(resp.Header.Get("Retry-After"), now); wait > 0 {
+			if until := now.Add(wait); until.After(l.reset) {
+				l.reset = until
+			}
+			l.tokens = 0
+		}
+	}
+}
+
+// RateLimitState is a snapshot of the client's view of the API rate limit.
+type RateLimitState struct {
+	Limit     int       // Requests allowed per minute
+	Remaining int       // Requests that can be sent before the limit is reached
+	Reset     time.Time // When the server's window resets, zero if unknown
+}
+
+// RateLimitState returns the current rate limit state.
+func (c *Client) RateLimitState() RateLimitState {
+	return c.limiter.state()
+}
+
+func (l *rateLimiter) state() RateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(l.now())
+
+	return RateLimitState{
+		Limit:     l.capacity,
+		Remaining: int(l.tokens),
+		Reset:     l.reset,
+	}
+}
+
+// jsDateSuffix matches the time zone name JavaScript appends to dates, e.g.
+// " (Coordinated Universal Time)".
+var jsDateSuffix = regexp.MustCompile(`\s*\([^)]*\)$`)
+
+var rateLimitResetLayouts = []string{
+	"Mon Jan 02 2006 15:04:05 GMT-0700", // JavaScript Date.toString()
+	time.RFC1123,
+	time.RFC1123Z,
+	time.RFC3339,
+}
+
+// parseRateLimitReset parses an X-RateLimit-Reset header. Habitica sends a
+// JavaScript date string, but epoch seconds, epoch milliseconds and
+// RFC1123/RFC3339 dates are accepted too.
+func parseRateLimitReset(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("empty rate limit reset")
+	}
+
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		// Epoch milliseconds have 13 digits until the year 2286
+		if n >= 1e12 {
+			return time.UnixMilli(n), nil
+		}
+		return time.Unix(n, 0), nil
+	}
+
+	value = jsDateSuffix.ReplaceAllString(value, "")
+	for _, layout := range rateLimitResetLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized rate limit reset: %q", value)
+}
@@ -0,0 +1,232 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLimiter returns a limiter driven by a fake clock.
+func newTestLimiter(requestsPerMinute, buffer int) (*rateLimiter, *time.Time) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	l := newRateLimiter(requestsPerMinute, buffer, 10)
+	l.now = func() time.Time { return now }
+	l.last = now
+	return l, &now
+}
+
+func rateLimitResponse(status int, headers map[string]string) *http.Response {
+	resp := &http.Response{StatusCode: status, Header: http.Header{}}
+	for k, v := range headers {
+		resp.Header.Set(k, v)
+	}
+	return resp
+}
+
+func TestRateLimiterBucket(t *testing.T) {
+	l, now := newTestLimiter(30, 5)
+
+	// 25 requests go through immediately, the last 5 are held in reserve
+	for i := range 25 {
+		require.Zero(t, l.take(), "request %d", i)
+	}
+	assert.Equal(t, 2*time.Second, l.take(), "one token refills every 2s")
+
+	*now = now.Add(2 * time.Second)
+	assert.Zero(t, l.take())
+
+	// The bucket never grows past the quota
+	*now = now.Add(time.Hour)
+	l.refill(*now)
+	assert.Equal(t, float64(30), l.tokens)
+}
+
+func TestRateLimiterServerReset(t *testing.T) {
+	l, now := newTestLimiter(30, 5)
+	reset := now.Add(40 * time.Second)
+
+	l.update(rateLimitResponse(http.StatusOK, map[string]string{
+		"X-RateLimit-Remaining": "3",
+		"X-RateLimit-Reset":     strconv.FormatInt(reset.Unix(), 10),
+	}))
+	assert.Equal(t, float64(3), l.tokens)
+
+	// With a known reset there is no continuous refill
+	assert.Equal(t, 40*time.Second, l.take())
+	*now = now.Add(30 * time.Second)
+	assert.Equal(t, 10*time.Second, l.take())
+
+	*now = reset
+	assert.Zero(t, l.take())
+	assert.Equal(t, float64(29), l.tokens)
+}
+
+func TestRateLimiterOnlyLowersWithinWindow(t *testing.T) {
+	l, now := newTestLimiter(30, 5)
+	reset := strconv.FormatInt(now.Add(time.Minute).Unix(), 10)
+
+	l.update(rateLimitResponse(http.StatusOK, map[string]string{
+		"X-RateLimit-Remaining": "20",
+		"X-RateLimit-Reset":     reset,
+	}))
+	require.Equal(t, float64(20), l.tokens)
+
+	for range 5 {
+		require.Zero(t, l.take())
+	}
+
+	// A late response reporting more remaining requests must not refill the bucket
+	l.update(rateLimitResponse(http.StatusOK, map[string]string{
+		"X-RateLimit-Remaining": "18",
+		"X-RateLimit-Reset":     reset,
+	}))
+	assert.Equal(t, float64(15), l.tokens)
+}
+
+func TestRateLimiterServerLowersLimitBelowBuffer(t *testing.T) {
+	l, now := newTestLimiter(100, 40)
+
+	l.update(rateLimitResponse(http.StatusOK, map[string]string{"X-RateLimit-Limit": "30"}))
+	assert.Equal(t, 30, l.capacity)
+	assert.Equal(t, 29, l.buffer)
+	assert.Equal(t, float64(30), l.tokens)
+
+	// One request per window is still allowed through
+	require.Zero(t, l.take())
+	assert.Equal(t, 2*time.Second, l.take())
+
+	*now = now.Add(2 * time.Second)
+	assert.Zero(t, l.take())
+}
+
+func TestRateLimiterRetryAfterPausesAll(t *testing.T) {
+	l, now := newTestLimiter(30, 5)
+
+	l.update(rateLimitResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "5"}))
+	assert.Equal(t, 5*time.Second, l.take())
+
+	*now = now.Add(5 * time.Second)
+	assert.Zero(t, l.take())
+}
+
+func TestRateLimiterAcquireContext(t *testing.T) {
+	l, _ := newTestLimiter(30, 29)
+	l.tokens = 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := l.acquire(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, l.sem, "a cancelled wait releases its slot")
+}
+
+func TestClientMaxConcurrentRequests(t *testing.T) {
+	var inFlight, peak atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:                "test-user",
+		APIKey:                "test-key",
+		ClientAuthorID:        "test-author",
+		MaxConcurrentRequests: 2,
+		BaseURL:               server.URL,
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Get(context.Background(), "/test")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), peak.Load())
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	want := time.Date(2024, 1, 15, 10, 1, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"epoch seconds", "1705312860"},
+		{"epoch milliseconds", "1705312860000"},
+		{"javascript date", "Mon Jan 15 2024 10:01:00 GMT+0000 (Coordinated Universal Time)"},
+		{"javascript date with offset", "Mon Jan 15 2024 11:01:00 GMT+0100 (Central European Standard Time)"},
+		{"rfc1123", "Mon, 15 Jan 2024 10:01:00 UTC"},
+		{"rfc1123z", "Mon, 15 Jan 2024 10:01:00 +0000"},
+		{"rfc3339", "2024-01-15T10:01:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRateLimitReset(tt.value)
+			require.NoError(t, err)
+			assert.True(t, want.Equal(got), "got %v", got)
+		})
+	}
+
+	for _, value := range []string{"", "soon", "Mon Jan 15 2024"} {
+		_, err := parseRateLimitReset(value)
+		assert.Error(t, err, "%q", value)
+	}
+}
+
+func TestClientRateLimitState(t *testing.T) {
+	reset := time.Now().Add(30 * time.Second).UTC().Truncate(time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "30")
+		w.Header().Set("X-RateLimit-Remaining", "12")
+		w.Header().Set("X-RateLimit-Reset", reset.Format("Mon Jan 02 2006 15:04:05 GMT-0700")+" (Coordinated Universal Time)")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+
+	initial := client.RateLimitState()
+	assert.Equal(t, 30, initial.Limit)
+	assert.True(t, initial.Reset.IsZero())
+
+	_, err := client.Get(context.Background(), "/test")
+	require.NoError(t, err)
+
+	state := client.RateLimitState()
+	assert.Equal(t, 30, state.Limit)
+	assert.Equal(t, 12, state.Remaining)
+	assert.True(t, reset.Equal(state.Reset), "got %v", state.Reset)
+}
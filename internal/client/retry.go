@@ -0,0 +1,67 @@
+package client
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// isIdempotent reports whether a request can safely be sent again after a
+// server error or a dropped connection.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether a response status is worth retrying. Rate
+// limited requests were never processed, so they are retried for any method.
+func shouldRetry(method string, statusCode int) bool {
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return statusCode >= 500 && isIdempotent(method)
+}
+
+// parseRetryAfter parses a Retry-After header given either as a number of
+// seconds or as an HTTP date. It returns zero if the header is absent or
+// invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+// retryDelay returns how long to wait before the given retry attempt. The
+// exponential backoff is raised to the server's Retry-After if that is longer,
+// then a random jitter is added so parallel callers do not retry in lockstep.
+func (c *Client) retryDelay(attempt int, retryAfter time.Duration) time.Duration {
+	delay := c.baseRetryDelay * time.Duration(1<<(attempt-1))
+	if retryAfter > delay {
+		delay = retryAfter
+	}
+
+	if c.retryJitter > 0 {
+		delay += time.Duration(rand.Float64() * c.retryJitter * float64(delay))
+	}
+
+	return delay
+}
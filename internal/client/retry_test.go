@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{"empty", "", 0},
+		{"seconds", "3", 3 * time.Second},
+		{"negative seconds", "-1", 0},
+		{"http date", "Mon, 15 Jan 2024 10:00:05 GMT", 5 * time.Second},
+		{"date in the past", "Mon, 15 Jan 2024 09:59:00 GMT", 0},
+		{"garbage", "soon", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRetryAfter(tt.value, now))
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	c := New(Config{BaseRetryDelay: 100 * time.Millisecond, RetryJitter: -1})
+
+	assert.Equal(t, 100*time.Millisecond, c.retryDelay(1, 0))
+	assert.Equal(t, 400*time.Millisecond, c.retryDelay(3, 0))
+	assert.Equal(t, 2*time.Second, c.retryDelay(1, 2*time.Second), "Retry-After wins when longer")
+	assert.Equal(t, 400*time.Millisecond, c.retryDelay(3, 50*time.Millisecond), "backoff wins when longer")
+
+	c = New(Config{BaseRetryDelay: 100 * time.Millisecond, RetryJitter: 0.5})
+	for range 50 {
+		d := c.retryDelay(1, 0)
+		assert.GreaterOrEqual(t, d, 100*time.Millisecond)
+		assert.Less(t, d, 150*time.Millisecond)
+	}
+}
+
+func TestClientHonoursRetryAfter(t *testing.T) {
+	var times []time.Time
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, time.Now())
+		if len(times) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"success":false,"error":"TooManyRequests"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseRetryDelay: 10 * time.Millisecond,
+		RetryJitter:    -1,
+		BaseURL:        server.URL,
+	})
+
+	_, err := client.Get(context.Background(), "/test")
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	assert.GreaterOrEqual(t, times[1].Sub(times[0]), time.Second)
+}
+
+func TestClientServerErrorRetries(t *testing.T) {
+	tests := []struct {
+		method       string
+		wantAttempts int
+	}{
+		{http.MethodGet, 3},
+		{http.MethodPut, 3},
+		{http.MethodDelete, 3},
+		{http.MethodPost, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`{"success":false,"error":"ServiceUnavailable"}`))
+			}))
+			defer server.Close()
+
+			client := New(Config{
+				UserID:         "test-user",
+				APIKey:         "test-key",
+				ClientAuthorID: "test-author",
+				MaxRetries:     2,
+				BaseRetryDelay: time.Millisecond,
+				BaseURL:        server.URL,
+			})
+
+			_, err := client.do(context.Background(), tt.method, "/test", nil)
+			require.Error(t, err)
+			assert.Equal(t, tt.wantAttempts, attempts)
+
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		})
+	}
+}
+
+func TestClientConnectionErrorRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		// Drop the connection without a response
+		conn, _, err := w.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		conn.Close()
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		MaxRetries:     2,
+		BaseRetryDelay: time.Millisecond,
+		BaseURL:        server.URL,
+	})
+
+	_, err := client.Get(context.Background(), "/test")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	_, err = client.Post(context.Background(), "/tasks/user", map[string]string{"text": "x"})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, 1, attempts, "POST is not resent after a dropped connection")
+}
+
+func TestClientRetriesDisabled(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		assert.Equal(t, "/test", r.URL.Path, "trailing slash on the base URL is dropped")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"success":false,"error":"TooManyRequests"}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		MaxRetries:     -1,
+		BaseURL:        server.URL + "/",
+	})
+
+	_, err := client.Get(context.Background(), "/test")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestClientRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		MaxRetries:     -1,
+		RequestTimeout: 10 * time.Millisecond,
+		BaseURL:        server.URL,
+	})
+
+	_, err := client.Get(context.Background(), "/test")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrRetriesExhausted)
+}
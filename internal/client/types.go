@@ -14,15 +14,19 @@ type APIResponse[T any] struct {
 type Tag struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
+	// Challenge is set on tags Habitica creates for joined challenges.
+	Challenge bool `json:"challenge,omitempty"`
 }
 
-// Task represents a Habitica task (habit, daily, todo, or reward).
+// Task is a Habitica task (habit, daily, todo, or reward) as returned by the
+// API. It includes gameplay state that is only ever read; requests use
+// CreateTaskRequest and UpdateTaskRequest instead.
 type Task struct {
 	ID        string   `json:"id,omitempty"`
 	Type      string   `json:"type"`
 	Text      string   `json:"text"`
 	Notes     string   `json:"notes,omitempty"`
-	Alias     string   `json:"alias,omitempty"`
+	Alias     *string  `json:"alias,omitempty"`
 	Tags      []string `json:"tags,omitempty"`
 	Priority  float64  `json:"priority,omitempty"`
 	Attribute string   `json:"attribute,omitempty"`
@@ -44,9 +48,71 @@ type Task struct {
 	Streak       int           `json:"streak,omitempty"`
 	IsDue        bool          `json:"isDue,omitempty"`
 	NextDue      []string      `json:"nextDue,omitempty"`
+	Reminders    []Reminder    `json:"reminders,omitempty"`
 
-	// Computed fields (read-only, gameplay-driven)
-	Value float64 `json:"value,omitempty"`
+	// Todo-specific fields
+	Date      *time.Time      `json:"date,omitempty"`
+	Checklist []ChecklistItem `json:"checklist,omitempty"`
+
+	// Value is gameplay-driven for habits, dailies and todos, and the gold
+	// cost of rewards.
+	Value *float64 `json:"value,omitempty"`
+}
+
+// CreateTaskRequest is the body of a request creating a task.
+type CreateTaskRequest struct {
+	Type string `json:"type"`
+	UpdateTaskRequest
+	Checklist []ChecklistItem `json:"checklist,omitempty"`
+}
+
+// UpdateTaskRequest holds the task fields set by the user. Gameplay state,
+// such as completion, streaks and counters, has no field here, so it is
+// never sent back to Habitica.
+type UpdateTaskRequest struct {
+	Text      string   `json:"text"`
+	Notes     string   `json:"notes,omitempty"`
+	Alias     *string  `json:"alias,omitempty"` // Empty removes the alias
+	Tags      []string `json:"tags,omitempty"`
+	Priority  float64  `json:"priority,omitempty"`
+	Attribute string   `json:"attribute,omitempty"`
+
+	// Habit-specific fields
+	Up   *bool `json:"up,omitempty"`
+	Down *bool `json:"down,omitempty"`
+
+	// Daily-specific fields
+	Frequency    string        `json:"frequency,omitempty"`
+	EveryX       int           `json:"everyX,omitempty"`
+	StartDate    *time.Time    `json:"startDate,omitempty"`
+	Repeat       *RepeatConfig `json:"repeat,omitempty"`
+	DaysOfMonth  []int         `json:"daysOfMonth,omitempty"`
+	WeeksOfMonth []int         `json:"weeksOfMonth,omitempty"`
+	// Reminders uses omitzero so that an empty, non-nil slice is still sent
+	// and clears the task's reminders.
+	Reminders []Reminder `json:"reminders,omitzero"`
+
+	// Todo-specific fields
+	Date *time.Time `json:"date,omitempty"`
+
+	// Value is the gold cost of a reward and must be nil for other types. It
+	// is a pointer to allow sending an explicit zero (a free reward).
+	Value *float64 `json:"value,omitempty"`
+}
+
+// Reminder is a notification Habitica sends for a daily or todo. Only the
+// time of day of Time is meaningful.
+type Reminder struct {
+	ID        string     `json:"id,omitempty"`
+	StartDate *time.Time `json:"startDate,omitempty"`
+	Time      time.Time  `json:"time"`
+}
+
+// ChecklistItem is a single sub-step of a daily or todo.
+type ChecklistItem struct {
+	ID        string `json:"id,omitempty"`
+	Text      string `json:"text"`
+	Completed bool   `json:"completed"`
 }
 
 // RepeatConfig defines which days of the week a daily repeats.
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gameplayFields are the task fields that Habitica updates as the user plays.
+// Sending any of them back would overwrite progress, e.g. reset a streak.
+var gameplayFields = []string{
+	"completed",
+	"streak",
+	"isDue",
+	"nextDue",
+	"counterUp",
+	"counterDown",
+	"history",
+	"yesterDaily",
+}
+
+// assertNoGameplayFields fails if the JSON object in body has a gameplay field.
+func assertNoGameplayFields(t *testing.T, body []byte) {
+	t.Helper()
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	for _, field := range gameplayFields {
+		assert.NotContains(t, decoded, field)
+	}
+}
+
+// filled returns a value of type T with every field set to a non-zero value,
+// so that nothing is left out by omitempty.
+func filled[T any]() *T {
+	v := new(T)
+	fill(reflect.ValueOf(v).Elem())
+	return v
+}
+
+func fill(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Date(2025, 1, 1, 7, 30, 0, 0, time.UTC)))
+			return
+		}
+		for i := range v.NumField() {
+			fill(v.Field(i))
+		}
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem())
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(v.Index(0))
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int:
+		v.SetInt(1)
+	case reflect.Float64:
+		v.SetFloat(1)
+	}
+}
+
+func TestTaskRequestsHaveNoGameplayFields(t *testing.T) {
+	create, err := json.Marshal(filled[CreateTaskRequest]())
+	require.NoError(t, err)
+	assertNoGameplayFields(t, create)
+
+	update, err := json.Marshal(filled[UpdateTaskRequest]())
+	require.NoError(t, err)
+	assertNoGameplayFields(t, update)
+}
+
+func TestTaskRequestsOmitValueUnlessSet(t *testing.T) {
+	body, err := json.Marshal(&CreateTaskRequest{Type: "habit", UpdateTaskRequest: UpdateTaskRequest{Text: "Exercise"}})
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "value")
+
+	free := 0.0
+	body, err = json.Marshal(&UpdateTaskRequest{Text: "Free reward", Value: &free})
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"value":0`)
+}
+
+// TestClientTaskWritesNeverSendGameplayFields validates that writing a task
+// with a long streak and counters, fetched into the cache first, sends none
+// of its gameplay state back
+func TestClientTaskWritesNeverSendGameplayFields(t *testing.T) {
+	const cached = `{"id":"daily-1","type":"daily","text":"Stretch","completed":true,"streak":300,` +
+		`"isDue":true,"nextDue":["2025-01-02"],"counterUp":4,"counterDown":2,"value":12.5,"frequency":"weekly","everyX":1}`
+
+	var bodies [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			if r.URL.Query().Get("type") == "completedTodos" {
+				w.Write([]byte(`{"success":true,"data":[]}`))
+				return
+			}
+			w.Write([]byte(`{"success":true,"data":[` + cached + `]}`))
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, body)
+		w.Write([]byte(`{"success":true,"data":` + cached + `}`))
+	}))
+	defer server.Close()
+
+	client := New(Config{
+		UserID:         "test-user",
+		APIKey:         "test-key",
+		ClientAuthorID: "test-author",
+		BaseURL:        server.URL,
+	})
+	ctx := context.Background()
+
+	task, err := client.GetTask(ctx, "daily-1")
+	require.NoError(t, err)
+	require.Equal(t, 300, task.Streak)
+
+	// Requests are built from the cached task's user fields only
+	prior := &UpdateTaskRequest{Text: task.Text, Frequency: task.Frequency, EveryX: task.EveryX}
+	planned := *prior
+	planned.Text = "Stretch well"
+
+	_, err = client.CreateTask(ctx, &CreateTaskRequest{Type: task.Type, UpdateTaskRequest: *prior})
+	require.NoError(t, err)
+	_, err = client.UpdateTask(ctx, task.ID, prior)
+	require.NoError(t, err)
+	_, err = client.PatchTask(ctx, task.ID, prior, &planned)
+	require.NoError(t, err)
+
+	require.Len(t, bodies, 3)
+	for _, body := range bodies {
+		assertNoGameplayFields(t, body)
+		assert.NotContains(t, string(body), "value")
+	}
+}
@@ -0,0 +1,112 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &tagDataSource{}
+	_ datasource.DataSourceWithConfigure = &tagDataSource{}
+)
+
+// NewTagDataSource returns a new data source looking up a single tag by name.
+func NewTagDataSource() datasource.DataSource {
+	return &tagDataSource{}
+}
+
+type tagDataSource struct {
+	client *client.Client
+}
+
+func (d *tagDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+func (d *tagDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a tag of the authenticated user by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the tag.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The exact name of the tag. Exactly one tag must have this name.",
+				Required:    true,
+			},
+			"challenge": schema.BoolAttribute{
+				Description: "Whether Habitica created the tag for a challenge.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *tagDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *tagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config tagModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := d.client.ListTags(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching tags", err.Error())
+		return
+	}
+
+	tag, err := findByName(tags, config.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error looking up tag", err.Error())
+		return
+	}
+
+	state := tagToModel(tag)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// findByName returns the only tag with the given name.
+func findByName(tags []client.Tag, name string) (*client.Tag, error) {
+	var matches []*client.Tag
+	for i := range tags {
+		if tags[i].Name == name {
+			matches = append(matches, &tags[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no tag named %q found", name)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, tag := range matches {
+			ids[i] = tag.ID
+		}
+		return nil, fmt.Errorf("more than one tag named %q found: %s", name, strings.Join(ids, ", "))
+	}
+}
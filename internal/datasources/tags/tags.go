@@ -0,0 +1,136 @@
+// Package tags provides the habitica_tags and habitica_tag data sources, so
+// that tags created outside Terraform, such as challenge tags, can be
+// referenced by name.
+package tags
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &tagsDataSource{}
+	_ datasource.DataSourceWithConfigure = &tagsDataSource{}
+)
+
+// NewTagsDataSource returns a new tags data source.
+func NewTagsDataSource() datasource.DataSource {
+	return &tagsDataSource{}
+}
+
+type tagsDataSource struct {
+	client *client.Client
+}
+
+type tagsModel struct {
+	Tags []tagModel `tfsdk:"tags"`
+}
+
+type tagModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Challenge types.Bool   `tfsdk:"challenge"`
+}
+
+func (d *tagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *tagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all tags of the authenticated user, including those created outside Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.ListNestedAttribute{
+				Description: "The user's tags, in the order Habitica lists them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the tag.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the tag.",
+							Computed:    true,
+						},
+						"challenge": schema.BoolAttribute{
+							Description: "Whether Habitica created the tag for a challenge.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *tagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tags, err := d.client.ListTags(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching tags", err.Error())
+		return
+	}
+
+	state := tagsModel{Tags: make([]tagModel, len(tags))}
+	for i := range tags {
+		state.Tags[i] = tagToModel(&tags[i])
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func tagToModel(tag *client.Tag) tagModel {
+	return tagModel{
+		ID:        types.StringValue(tag.ID),
+		Name:      types.StringValue(tag.Name),
+		Challenge: types.BoolValue(tag.Challenge),
+	}
+}
+
+// Names returns the names of the user's tags, keyed by tag ID.
+func Names(ctx context.Context, c *client.Client) (map[string]string, error) {
+	tags, err := c.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		names[tag.ID] = tag.Name
+	}
+	return names, nil
+}
+
+// Resolve returns the names of the tags with the given IDs, in order. IDs of
+// tags that no longer exist are skipped.
+func Resolve(ids []string, names map[string]string) []string {
+	resolved := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name, ok := names[id]; ok {
+			resolved = append(resolved, name)
+		}
+	}
+	return resolved
+}
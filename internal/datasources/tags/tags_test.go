@@ -0,0 +1,64 @@
+package tags
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTagsChallengeFlag validates that challenge tags are told apart from the
+// user's own
+func TestTagsChallengeFlag(t *testing.T) {
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tags": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"success":true,"data":[
+				{"id":"tag-uuid-1","name":"work"},
+				{"id":"tag-uuid-2","name":"30 Day Yoga","challenge":true}
+			]}`))
+		},
+	})
+	defer server.Close()
+
+	c := testutil.NewTestClient(server.URL)
+	tags, err := c.ListTags(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+
+	assert.Equal(t, tagModel{
+		ID:        types.StringValue("tag-uuid-1"),
+		Name:      types.StringValue("work"),
+		Challenge: types.BoolValue(false),
+	}, tagToModel(&tags[0]))
+	assert.Equal(t, types.BoolValue(true), tagToModel(&tags[1]).Challenge)
+}
+
+// TestFindByName validates lookup of a single tag by exact name
+func TestFindByName(t *testing.T) {
+	tags := []client.Tag{testutil.TestTag1, testutil.TestTag2, testutil.TestTag3}
+
+	tag, err := findByName(tags, "exercise")
+	require.NoError(t, err)
+	assert.Equal(t, "tag-uuid-2", tag.ID)
+
+	_, err = findByName(tags, "Exercise")
+	assert.ErrorContains(t, err, `no tag named "Exercise" found`)
+
+	dup := append(tags, client.Tag{ID: "tag-uuid-4", Name: "work"})
+	_, err = findByName(dup, "work")
+	assert.ErrorContains(t, err, "tag-uuid-1, tag-uuid-4")
+}
+
+// TestResolve validates that tag IDs resolve to names and unknown IDs are skipped
+func TestResolve(t *testing.T) {
+	names := map[string]string{"tag-uuid-1": "work", "tag-uuid-2": "exercise"}
+
+	assert.Equal(t, []string{"exercise", "work"}, Resolve([]string{"tag-uuid-2", "deleted", "tag-uuid-1"}, names))
+	assert.Equal(t, []string{}, Resolve(nil, names))
+}
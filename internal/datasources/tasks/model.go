@@ -0,0 +1,275 @@
+// Package tasks provides the habitica_tasks and habitica_task data sources,
+// which return the user's tasks as typed attributes.
+package tasks
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/datasources/tags"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/difficulty"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/stat"
+)
+
+// taskModel is a task with every field the API returns. Fields that do not
+// apply to the task's type are null.
+type taskModel struct {
+	ID           types.String         `tfsdk:"id"`
+	Type         types.String         `tfsdk:"type"`
+	Text         types.String         `tfsdk:"text"`
+	Alias        types.String         `tfsdk:"alias"`
+	Notes        types.String         `tfsdk:"notes"`
+	Priority     types.Float64        `tfsdk:"priority"`
+	Difficulty   types.String         `tfsdk:"difficulty"`
+	Attribute    types.String         `tfsdk:"attribute"`
+	Tags         []types.String       `tfsdk:"tags"`
+	TagNames     []types.String       `tfsdk:"tag_names"`
+	Up           types.Bool           `tfsdk:"up"`
+	Down         types.Bool           `tfsdk:"down"`
+	CounterUp    types.Int64          `tfsdk:"counter_up"`
+	CounterDown  types.Int64          `tfsdk:"counter_down"`
+	Frequency    types.String         `tfsdk:"frequency"`
+	EveryX       types.Int64          `tfsdk:"every_x"`
+	StartDate    types.String         `tfsdk:"start_date"`
+	Repeat       *repeatModel         `tfsdk:"repeat"`
+	DaysOfMonth  []types.Int64        `tfsdk:"days_of_month"`
+	WeeksOfMonth []types.Int64        `tfsdk:"weeks_of_month"`
+	Completed    types.Bool           `tfsdk:"completed"`
+	IsDue        types.Bool           `tfsdk:"is_due"`
+	Streak       types.Int64          `tfsdk:"streak"`
+	NextDue      []types.String       `tfsdk:"next_due"`
+	Date         types.String         `tfsdk:"date"`
+	Value        types.Float64        `tfsdk:"value"`
+	Checklist    []checklistItemModel `tfsdk:"checklist"`
+	Reminders    []reminderModel      `tfsdk:"reminders"`
+}
+
+type repeatModel struct {
+	Monday    types.Bool `tfsdk:"monday"`
+	Tuesday   types.Bool `tfsdk:"tuesday"`
+	Wednesday types.Bool `tfsdk:"wednesday"`
+	Thursday  types.Bool `tfsdk:"thursday"`
+	Friday    types.Bool `tfsdk:"friday"`
+	Saturday  types.Bool `tfsdk:"saturday"`
+	Sunday    types.Bool `tfsdk:"sunday"`
+}
+
+type checklistItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Text      types.String `tfsdk:"text"`
+	Completed types.Bool   `tfsdk:"completed"`
+}
+
+type reminderModel struct {
+	ID        types.String `tfsdk:"id"`
+	Time      types.String `tfsdk:"time"`
+	StartDate types.String `tfsdk:"start_date"`
+}
+
+// taskAttributes returns the computed schema attributes of a task.
+func taskAttributes() map[string]schema.Attribute {
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{Description: description, Computed: true}
+	}
+	computedBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{Description: description, Computed: true}
+	}
+	computedInt := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{Description: description, Computed: true}
+	}
+
+	return map[string]schema.Attribute{
+		"id":         computedString("The unique identifier of the task."),
+		"type":       computedString("The task type: 'habit', 'daily', 'todo' or 'reward'."),
+		"text":       computedString("The title of the task."),
+		"alias":      computedString("The alias of the task, if any."),
+		"notes":      computedString("Extra notes or description for the task."),
+		"priority":   schema.Float64Attribute{Description: "Difficulty of the task as a number.", Computed: true},
+		"difficulty": computedString("Difficulty of the task by name, null for rewards."),
+		"attribute":  computedString("The stat trained by scoring the task."),
+		"tags": schema.ListAttribute{
+			Description: "IDs of the task's tags.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"tag_names": schema.ListAttribute{
+			Description: "Names of the task's tags.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"up":           computedBool("Whether a habit can be scored positively."),
+		"down":         computedBool("Whether a habit can be scored negatively."),
+		"counter_up":   computedInt("How many times a habit was scored positively in the current period."),
+		"counter_down": computedInt("How many times a habit was scored negatively in the current period."),
+		"frequency":    computedString("How often a daily repeats, or a habit's counters reset."),
+		"every_x":      computedInt("A daily repeats every X periods."),
+		"start_date":   computedString("Start date of a daily in YYYY-MM-DD format."),
+		"repeat": schema.SingleNestedAttribute{
+			Description: "Which days of the week a daily repeats.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"monday":    computedBool("Repeat on Monday."),
+				"tuesday":   computedBool("Repeat on Tuesday."),
+				"wednesday": computedBool("Repeat on Wednesday."),
+				"thursday":  computedBool("Repeat on Thursday."),
+				"friday":    computedBool("Repeat on Friday."),
+				"saturday":  computedBool("Repeat on Saturday."),
+				"sunday":    computedBool("Repeat on Sunday."),
+			},
+		},
+		"days_of_month": schema.ListAttribute{
+			Description: "Days of the month a daily repeats on.",
+			Computed:    true,
+			ElementType: types.Int64Type,
+		},
+		"weeks_of_month": schema.ListAttribute{
+			Description: "Weeks of the month a daily repeats on.",
+			Computed:    true,
+			ElementType: types.Int64Type,
+		},
+		"completed": computedBool("Whether a daily or todo is completed."),
+		"is_due":    computedBool("Whether a daily is due today."),
+		"streak":    computedInt("The current streak of a daily."),
+		"next_due": schema.ListAttribute{
+			Description: "The next dates a daily is due.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"date":  computedString("Due date of a todo in YYYY-MM-DD format."),
+		"value": schema.Float64Attribute{Description: "The gold cost of a reward, or the task's value for other types.", Computed: true},
+		"checklist": schema.ListNestedAttribute{
+			Description: "Checklist items of a daily or todo.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id":        computedString("The unique identifier of the item."),
+					"text":      computedString("The text of the item."),
+					"completed": computedBool("Whether the item is checked off."),
+				},
+			},
+		},
+		"reminders": schema.ListNestedAttribute{
+			Description: "Reminders of a daily or todo.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id":         computedString("The unique identifier of the reminder."),
+					"time":       computedString("Time of day in HH:MM (24-hour, UTC) format."),
+					"start_date": computedString("Date from which the reminder is active, in YYYY-MM-DD format."),
+				},
+			},
+		},
+	}
+}
+
+// taskToModel converts an API task into its model, resolving tag names with
+// tagNames.
+func taskToModel(task *client.Task, tagNames map[string]string) taskModel {
+	model := taskModel{
+		ID:           types.StringValue(task.ID),
+		Type:         types.StringValue(task.Type),
+		Text:         types.StringValue(task.Text),
+		Alias:        types.StringNull(),
+		Notes:        types.StringValue(task.Notes),
+		Priority:     types.Float64Value(task.Priority),
+		Difficulty:   types.StringNull(),
+		Attribute:    stat.FromTask(task),
+		Tags:         stringValues(task.Tags),
+		TagNames:     stringValues(tags.Resolve(task.Tags, tagNames)),
+		Up:           types.BoolPointerValue(task.Up),
+		Down:         types.BoolPointerValue(task.Down),
+		CounterUp:    types.Int64Null(),
+		CounterDown:  types.Int64Null(),
+		Frequency:    types.StringNull(),
+		EveryX:       types.Int64Null(),
+		StartDate:    types.StringNull(),
+		DaysOfMonth:  int64Values(task.DaysOfMonth),
+		WeeksOfMonth: int64Values(task.WeeksOfMonth),
+		Completed:    types.BoolNull(),
+		IsDue:        types.BoolNull(),
+		Streak:       types.Int64Null(),
+		NextDue:      stringValues(task.NextDue),
+		Date:         types.StringNull(),
+		Value:        types.Float64PointerValue(task.Value),
+		Checklist:    make([]checklistItemModel, len(task.Checklist)),
+		Reminders:    make([]reminderModel, len(task.Reminders)),
+	}
+
+	if task.Alias != nil && *task.Alias != "" {
+		model.Alias = types.StringValue(*task.Alias)
+	}
+	if task.Type != "reward" {
+		model.Difficulty = difficulty.FromPriority(task.Priority)
+	}
+
+	switch task.Type {
+	case "habit":
+		model.CounterUp = types.Int64Value(int64(task.CounterUp))
+		model.CounterDown = types.Int64Value(int64(task.CounterDown))
+		model.Frequency = types.StringValue(task.Frequency)
+	case "daily":
+		model.Frequency = types.StringValue(task.Frequency)
+		model.EveryX = types.Int64Value(int64(task.EveryX))
+		model.Completed = types.BoolValue(task.Completed)
+		model.IsDue = types.BoolValue(task.IsDue)
+		model.Streak = types.Int64Value(int64(task.Streak))
+	case "todo":
+		model.Completed = types.BoolValue(task.Completed)
+	}
+
+	if task.StartDate != nil {
+		model.StartDate = types.StringValue(task.StartDate.Format("2006-01-02"))
+	}
+	if task.Date != nil {
+		model.Date = types.StringValue(task.Date.Format("2006-01-02"))
+	}
+
+	if task.Repeat != nil {
+		model.Repeat = &repeatModel{
+			Monday:    types.BoolValue(task.Repeat.Monday),
+			Tuesday:   types.BoolValue(task.Repeat.Tuesday),
+			Wednesday: types.BoolValue(task.Repeat.Wednesday),
+			Thursday:  types.BoolValue(task.Repeat.Thursday),
+			Friday:    types.BoolValue(task.Repeat.Friday),
+			Saturday:  types.BoolValue(task.Repeat.Saturday),
+			Sunday:    types.BoolValue(task.Repeat.Sunday),
+		}
+	}
+
+	for i, item := range task.Checklist {
+		model.Checklist[i] = checklistItemModel{
+			ID:        types.StringValue(item.ID),
+			Text:      types.StringValue(item.Text),
+			Completed: types.BoolValue(item.Completed),
+		}
+	}
+
+	for i, rem := range task.Reminders {
+		model.Reminders[i] = reminderModel{
+			ID:        types.StringValue(rem.ID),
+			Time:      types.StringValue(rem.Time.UTC().Format("15:04")),
+			StartDate: types.StringNull(),
+		}
+		if rem.StartDate != nil {
+			model.Reminders[i].StartDate = types.StringValue(rem.StartDate.UTC().Format("2006-01-02"))
+		}
+	}
+
+	return model
+}
+
+func stringValues(values []string) []types.String {
+	result := make([]types.String, len(values))
+	for i, v := range values {
+		result[i] = types.StringValue(v)
+	}
+	return result
+}
+
+func int64Values(values []int) []types.Int64 {
+	result := make([]types.Int64, len(values))
+	for i, v := range values {
+		result[i] = types.Int64Value(int64(v))
+	}
+	return result
+}
@@ -0,0 +1,201 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/datasources/tags"
+	"github.com/inannamalick/terraform-provider-habitica/internal/validate"
+)
+
+var (
+	_ datasource.DataSource                   = &taskDataSource{}
+	_ datasource.DataSourceWithConfigure      = &taskDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &taskDataSource{}
+)
+
+// NewTaskDataSource returns a new data source looking up a single task.
+func NewTaskDataSource() datasource.DataSource {
+	return &taskDataSource{}
+}
+
+type taskDataSource struct {
+	client *client.Client
+}
+
+func (d *taskDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task"
+}
+
+func (d *taskDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := taskAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The unique identifier of the task. Conflicts with alias and text.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["alias"] = schema.StringAttribute{
+		Description: "The alias of the task. Conflicts with id and text.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["text"] = schema.StringAttribute{
+		Description: "The exact title of the task. Exactly one task must have this title. Completed todos are only " +
+			"searched when type is 'todo', and then only Habitica's list of recently completed todos. " +
+			"Conflicts with id and alias.",
+		Optional: true,
+		Computed: true,
+	}
+	attributes["type"] = schema.StringAttribute{
+		Description: "The task type: 'habit', 'daily', 'todo' or 'reward'. If set, only tasks of this type are looked up.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			validate.OneOf(taskTypes...),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single task of the authenticated user by id, alias or exact text, " +
+			"such as a task created in the app or by a challenge.",
+		Attributes: attributes,
+	}
+}
+
+func (d *taskDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *taskDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config taskModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values may turn out to be null, so only check once known
+	set := 0
+	for _, value := range []attr.Value{config.ID, config.Alias, config.Text} {
+		if value.IsUnknown() {
+			return
+		}
+		if !value.IsNull() {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid task lookup",
+			"Exactly one of id, alias or text must be set.")
+	}
+}
+
+func (d *taskDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config taskModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := d.findTask(ctx, &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error looking up task", err.Error())
+		return
+	}
+
+	tagNames, err := tags.Names(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching tags", err.Error())
+		return
+	}
+
+	state := taskToModel(task, tagNames)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// findTask returns the only task matching the configured lookup attribute
+// and type.
+func (d *taskDataSource) findTask(ctx context.Context, config *taskModel) (*client.Task, error) {
+	taskType := config.Type.ValueString()
+
+	if !config.ID.IsNull() {
+		id := config.ID.ValueString()
+		task, err := d.client.GetTask(ctx, id)
+		if errors.Is(err, client.ErrNotFound) || (err == nil && task.ID != id) {
+			return nil, fmt.Errorf("no task with ID %q found", id)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if taskType != "" && task.Type != taskType {
+			return nil, fmt.Errorf("task %q is a %s, not a %s", id, task.Type, taskType)
+		}
+		return task, nil
+	}
+
+	// Aliases are unique, but old completed todos would make text lookups
+	// ambiguous, so those only search them when asked for todos
+	tasks, err := d.client.ListTasks(ctx, client.ListTasksOptions{
+		Type:                  taskType,
+		IncludeCompletedTodos: config.Text.IsNull() || taskType == "todo",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.Alias.IsNull() {
+		alias := config.Alias.ValueString()
+		return single(tasks, fmt.Sprintf("task with alias %q", alias), func(task *client.Task) bool {
+			return task.Alias != nil && *task.Alias == alias
+		})
+	}
+
+	text := config.Text.ValueString()
+	return single(tasks, fmt.Sprintf("task with text %q", text), func(task *client.Task) bool {
+		return task.Text == text
+	})
+}
+
+// single returns the only task that matches, or an error naming what was
+// looked up.
+func single(tasks []client.Task, what string, match func(*client.Task) bool) (*client.Task, error) {
+	var matches []*client.Task
+	for i := range tasks {
+		if match(&tasks[i]) {
+			matches = append(matches, &tasks[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no %s found", what)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, task := range matches {
+			ids[i] = fmt.Sprintf("%s (%s)", task.ID, task.Type)
+		}
+		return nil, fmt.Errorf("more than one %s found, look it up by id instead: %s", what, strings.Join(ids, ", "))
+	}
+}
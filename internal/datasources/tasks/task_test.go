@@ -0,0 +1,176 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTaskDataSourceFindTask validates lookups by id, alias and text
+func TestTaskDataSourceFindTask(t *testing.T) {
+	habit := testutil.TestHabit1
+	habit.Alias = testutil.StringPtr("exercise")
+	// A daily with the same text as the habit
+	daily := testutil.TestDaily2
+	daily.Text = habit.Text
+	// A completed todo with the same text again
+	doneTodo := testutil.TestTodo1
+	doneTodo.ID = "todo-uuid-done"
+	doneTodo.Text = habit.Text
+	doneTodo.Alias = testutil.StringPtr("done")
+	doneTodo.Completed = true
+
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tasks/user": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Query().Get("type") {
+			case "completedTodos":
+				w.Write(testutil.MockTasksResponse([]client.Task{doneTodo}))
+			case "todos":
+				w.Write(testutil.MockTasksResponse([]client.Task{}))
+			case "habits":
+				w.Write(testutil.MockTasksResponse([]client.Task{habit}))
+			default:
+				w.Write(testutil.MockTasksResponse([]client.Task{habit, testutil.TestDaily1, daily}))
+			}
+		},
+	})
+	defer server.Close()
+
+	d := &taskDataSource{client: testutil.NewTestClient(server.URL)}
+	ctx := context.Background()
+
+	lookup := func(id, alias, text, taskType string) taskModel {
+		config := taskModel{ID: types.StringNull(), Alias: types.StringNull(), Text: types.StringNull(), Type: types.StringNull()}
+		if id != "" {
+			config.ID = types.StringValue(id)
+		}
+		if alias != "" {
+			config.Alias = types.StringValue(alias)
+		}
+		if text != "" {
+			config.Text = types.StringValue(text)
+		}
+		if taskType != "" {
+			config.Type = types.StringValue(taskType)
+		}
+		return config
+	}
+
+	tests := []struct {
+		name    string
+		config  taskModel
+		wantID  string
+		wantErr string
+	}{
+		{name: "by id", config: lookup("daily-uuid-1", "", "", ""), wantID: "daily-uuid-1"},
+		{name: "by alias", config: lookup("", "exercise", "", ""), wantID: "habit-uuid-1"},
+		{name: "by text", config: lookup("", "", "Morning routine", ""), wantID: "daily-uuid-1"},
+		{name: "by text and type", config: lookup("", "", "Exercise", "habit"), wantID: "habit-uuid-1"},
+		{name: "completed todo by alias", config: lookup("", "done", "", ""), wantID: "todo-uuid-done"},
+		{name: "completed todo by text and type", config: lookup("", "", "Exercise", "todo"), wantID: "todo-uuid-done"},
+		{name: "unknown id", config: lookup("missing", "", "", ""), wantErr: `no task with ID "missing" found`},
+		{name: "alias given as id", config: lookup("exercise", "", "", ""), wantErr: `no task with ID "exercise" found`},
+		{name: "wrong type", config: lookup("daily-uuid-1", "", "", "habit"), wantErr: `task "daily-uuid-1" is a daily, not a habit`},
+		{name: "unknown alias", config: lookup("", "run", "", ""), wantErr: `no task with alias "run" found`},
+		{
+			name:    "ambiguous text",
+			config:  lookup("", "", "Exercise", ""),
+			wantErr: `more than one task with text "Exercise" found, look it up by id instead: habit-uuid-1 (habit), daily-uuid-2 (daily)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, err := d.findTask(ctx, &tt.config)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, task.ID)
+		})
+	}
+}
+
+// TestTaskDataSourceValidateConfig validates that exactly one lookup attribute is required
+func TestTaskDataSourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	d := &taskDataSource{}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := func(values map[string]tftypes.Value) tfsdk.Config {
+		attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, typ := range objectType.AttributeTypes {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+		for name, v := range values {
+			attrs[name] = v
+		}
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attrs)}
+	}
+
+	tests := []struct {
+		name    string
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		{name: "id", values: map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "daily-uuid-1")}},
+		{name: "text and type", values: map[string]tftypes.Value{
+			"text": tftypes.NewValue(tftypes.String, "Exercise"),
+			"type": tftypes.NewValue(tftypes.String, "habit"),
+		}},
+		{name: "unknown alias", values: map[string]tftypes.Value{"alias": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}},
+		{name: "none", wantErr: true},
+		{name: "two", wantErr: true, values: map[string]tftypes.Value{
+			"id":    tftypes.NewValue(tftypes.String, "daily-uuid-1"),
+			"alias": tftypes.NewValue(tftypes.String, "exercise"),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp datasource.ValidateConfigResponse
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: config(tt.values)}, &resp)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		})
+	}
+}
+
+// TestTaskDataSourceTypeValidator validates that an invalid type is rejected
+// by the schema, whether or not the lookup attributes are known yet
+func TestTaskDataSourceTypeValidator(t *testing.T) {
+	var resp datasource.SchemaResponse
+	NewTaskDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+	typeAttr := resp.Schema.Attributes["type"].(schema.StringAttribute)
+
+	for value, valid := range map[string]bool{
+		"habit":   true,
+		"todo":    true,
+		"dailies": false,
+		"Habit":   false,
+	} {
+		req := validator.StringRequest{Path: path.Root("type"), ConfigValue: types.StringValue(value)}
+		validResp := &validator.StringResponse{}
+		for _, v := range typeAttr.Validators {
+			v.ValidateString(context.Background(), req, validResp)
+		}
+		assert.Equal(t, !valid, validResp.Diagnostics.HasError(), "%q", value)
+	}
+}
@@ -0,0 +1,186 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/datasources/tags"
+	"github.com/inannamalick/terraform-provider-habitica/internal/validate"
+)
+
+var (
+	_ datasource.DataSource              = &tasksDataSource{}
+	_ datasource.DataSourceWithConfigure = &tasksDataSource{}
+)
+
+// NewTasksDataSource returns a new tasks data source.
+func NewTasksDataSource() datasource.DataSource {
+	return &tasksDataSource{}
+}
+
+type tasksDataSource struct {
+	client *client.Client
+}
+
+type tasksModel struct {
+	Type      types.String `tfsdk:"type"`
+	Tag       types.String `tfsdk:"tag"`
+	DueToday  types.Bool   `tfsdk:"due_today"`
+	Completed types.Bool   `tfsdk:"completed"`
+	TextRegex types.String `tfsdk:"text_regex"`
+	Alias     types.String `tfsdk:"alias"`
+	Tasks     []taskModel  `tfsdk:"tasks"`
+}
+
+var taskTypes = []string{"habit", "daily", "todo", "reward"}
+
+// taskFilter selects tasks. Unset fields match every task.
+type taskFilter struct {
+	tag       string
+	dueToday  *bool
+	completed *bool
+	text      *regexp.Regexp
+	alias     string
+}
+
+func (d *tasksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tasks"
+}
+
+func (d *tasksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the tasks of the authenticated user, optionally filtered. All filters must match.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return tasks of this type: 'habit', 'daily', 'todo' or 'reward'.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.OneOf(taskTypes...),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Description: "Only return tasks with a tag of this name.",
+				Optional:    true,
+			},
+			"due_today": schema.BoolAttribute{
+				Description: "Only return dailies that are (true) or are not (false) due today.",
+				Optional:    true,
+			},
+			"completed": schema.BoolAttribute{
+				Description: "Only return dailies and todos that are (true) or are not (false) completed. " +
+					"Completed todos are only returned when this is true, and then only those in Habitica's list of " +
+					"recently completed todos; older completed todos are never returned.",
+				Optional: true,
+			},
+			"text_regex": schema.StringAttribute{
+				Description: "Only return tasks whose text matches this regular expression (RE2 syntax).",
+				Optional:    true,
+			},
+			"alias": schema.StringAttribute{
+				Description: "Only return the task with this alias.",
+				Optional:    true,
+			},
+			"tasks": schema.ListNestedAttribute{
+				Description: "The matching tasks, in the order Habitica lists them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: taskAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *tasksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *tasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config tasksModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := taskFilter{
+		tag:       config.Tag.ValueString(),
+		dueToday:  config.DueToday.ValueBoolPointer(),
+		completed: config.Completed.ValueBoolPointer(),
+		alias:     config.Alias.ValueString(),
+	}
+	if !config.TextRegex.IsNull() {
+		re, err := regexp.Compile(config.TextRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("text_regex"), "Invalid text_regex", err.Error())
+			return
+		}
+		filter.text = re
+	}
+
+	tasks, err := d.client.ListTasks(ctx, client.ListTasksOptions{
+		Type:                  config.Type.ValueString(),
+		IncludeCompletedTodos: filter.completed != nil && *filter.completed,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching tasks", err.Error())
+		return
+	}
+
+	tagNames, err := tags.Names(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching tags", err.Error())
+		return
+	}
+
+	config.Tasks = []taskModel{}
+	for i := range tasks {
+		if filter.match(&tasks[i], tagNames) {
+			config.Tasks = append(config.Tasks, taskToModel(&tasks[i], tagNames))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// match reports whether task passes every filter that is set.
+func (f taskFilter) match(task *client.Task, tagNames map[string]string) bool {
+	if f.tag != "" && !slices.Contains(tags.Resolve(task.Tags, tagNames), f.tag) {
+		return false
+	}
+	// Only dailies are due on particular days
+	if f.dueToday != nil && (task.Type != "daily" || task.IsDue != *f.dueToday) {
+		return false
+	}
+	// Habits and rewards are never completed
+	if f.completed != nil && ((task.Type != "daily" && task.Type != "todo") || task.Completed != *f.completed) {
+		return false
+	}
+	if f.text != nil && !f.text.MatchString(task.Text) {
+		return false
+	}
+	if f.alias != "" && (task.Alias == nil || *task.Alias != f.alias) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,279 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTagNames = map[string]string{
+	testutil.TestTag1.ID: testutil.TestTag1.Name,
+	testutil.TestTag2.ID: testutil.TestTag2.Name,
+	testutil.TestTag3.ID: testutil.TestTag3.Name,
+}
+
+// TestTaskFilterMatch validates each filter on its own
+func TestTaskFilterMatch(t *testing.T) {
+	habit := testutil.TestHabit1
+	habit.Alias = testutil.StringPtr("exercise")
+	all := []client.Task{habit, testutil.TestDaily1, testutil.TestDaily2, testutil.TestTodo1, testutil.TestReward1}
+
+	yes, no := true, false
+	tests := []struct {
+		name   string
+		filter taskFilter
+		want   []string
+	}{
+		{
+			name: "no filters",
+			want: []string{"habit-uuid-1", "daily-uuid-1", "daily-uuid-2", "todo-uuid-1", "reward-uuid-1"},
+		},
+		{
+			name:   "tag name",
+			filter: taskFilter{tag: "work"},
+			want:   []string{"todo-uuid-1", "reward-uuid-1"},
+		},
+		{
+			name:   "due today",
+			filter: taskFilter{dueToday: &yes},
+			want:   []string{"daily-uuid-1"},
+		},
+		{
+			name:   "not due today only matches dailies",
+			filter: taskFilter{dueToday: &no},
+			want:   []string{"daily-uuid-2"},
+		},
+		{
+			name:   "completed",
+			filter: taskFilter{completed: &yes},
+			want:   []string{"daily-uuid-2"},
+		},
+		{
+			name:   "not completed only matches dailies and todos",
+			filter: taskFilter{completed: &no},
+			want:   []string{"daily-uuid-1", "todo-uuid-1"},
+		},
+		{
+			name:   "text regex",
+			filter: taskFilter{text: regexp.MustCompile(`^(Morning|Take) `)},
+			want:   []string{"daily-uuid-1", "daily-uuid-2"},
+		},
+		{
+			name:   "alias",
+			filter: taskFilter{alias: "exercise"},
+			want:   []string{"habit-uuid-1"},
+		},
+		{
+			name:   "filters combine",
+			filter: taskFilter{tag: "tier:foundation", completed: &yes},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for i := range all {
+				if tt.filter.match(&all[i], testTagNames) {
+					got = append(got, all[i].ID)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestTaskToModelDaily validates that daily fields and tag names are exposed
+func TestTaskToModelDaily(t *testing.T) {
+	model := taskToModel(&testutil.TestDaily1, testTagNames)
+
+	assert.Equal(t, "daily", model.Type.ValueString())
+	assert.Equal(t, "medium", model.Difficulty.ValueString())
+	assert.Equal(t, []types.String{types.StringValue("tag-uuid-3")}, model.Tags)
+	assert.Equal(t, []types.String{types.StringValue("tier:foundation")}, model.TagNames)
+	assert.Equal(t, "2025-01-01", model.StartDate.ValueString())
+	require.NotNil(t, model.Repeat)
+	assert.True(t, model.Repeat.Monday.ValueBool())
+	assert.False(t, model.Repeat.Sunday.ValueBool())
+	assert.True(t, model.IsDue.ValueBool())
+	assert.Equal(t, int64(5), model.Streak.ValueInt64())
+	require.Len(t, model.Checklist, 2)
+	assert.True(t, model.Checklist[0].Completed.ValueBool())
+
+	assert.True(t, model.CounterUp.IsNull(), "habit counters are null for dailies")
+	assert.True(t, model.Date.IsNull())
+	assert.True(t, model.Value.IsNull())
+}
+
+// TestTaskToModelOtherTypes validates that fields of other types are null
+func TestTaskToModelOtherTypes(t *testing.T) {
+	habit := taskToModel(&testutil.TestHabit1, testTagNames)
+	assert.True(t, habit.Up.ValueBool())
+	assert.False(t, habit.Down.ValueBool())
+	assert.Equal(t, int64(0), habit.CounterUp.ValueInt64())
+	assert.True(t, habit.Completed.IsNull())
+	assert.True(t, habit.Streak.IsNull())
+	assert.True(t, habit.Alias.IsNull())
+	assert.Nil(t, habit.Repeat)
+
+	todo := taskToModel(&testutil.TestTodo1, testTagNames)
+	assert.Equal(t, "2025-02-01", todo.Date.ValueString())
+	assert.False(t, todo.Completed.ValueBool())
+	assert.True(t, todo.IsDue.IsNull())
+
+	reward := taskToModel(&testutil.TestReward1, testTagNames)
+	assert.Equal(t, 25.0, reward.Value.ValueFloat64())
+	assert.True(t, reward.Difficulty.IsNull())
+	assert.True(t, reward.Up.IsNull())
+}
+
+// TestTasksSchemaMatchesModel validates that every task type can be stored in
+// state with the data source schema
+func TestTasksSchemaMatchesModel(t *testing.T) {
+	ctx := context.Background()
+
+	var resp datasource.SchemaResponse
+	NewTasksDataSource().Schema(ctx, datasource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	state := tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	model := tasksModel{
+		Type:      types.StringNull(),
+		Tag:       types.StringValue("work"),
+		DueToday:  types.BoolNull(),
+		Completed: types.BoolNull(),
+		TextRegex: types.StringNull(),
+		Alias:     types.StringNull(),
+	}
+	for _, task := range []client.Task{testutil.TestHabit1, testutil.TestDaily1, testutil.TestTodo1, testutil.TestReward1} {
+		model.Tasks = append(model.Tasks, taskToModel(&task, testTagNames))
+	}
+
+	diags := state.Set(ctx, &model)
+	require.False(t, diags.HasError(), "%v", diags)
+}
+
+// TestTasksDataSourceRead validates that the type filter fetches only that
+// type, and that completed todos are merged in only when asked for
+func TestTasksDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	doneTodo := testutil.TestTodo1
+	doneTodo.ID = "todo-uuid-done"
+	doneTodo.Completed = true
+
+	byQuery := map[string][]client.Task{
+		"":               {testutil.TestHabit1, testutil.TestDaily1, testutil.TestTodo1, testutil.TestReward1},
+		"habits":         {testutil.TestHabit1},
+		"dailys":         {testutil.TestDaily1},
+		"todos":          {testutil.TestTodo1},
+		"rewards":        {testutil.TestReward1},
+		"completedTodos": {doneTodo},
+	}
+
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		wantQueries []string
+		wantIDs     []string
+	}{
+		{
+			name:        "all types",
+			wantQueries: []string{""},
+			wantIDs:     []string{"habit-uuid-1", "daily-uuid-1", "todo-uuid-1", "reward-uuid-1"},
+		},
+		{
+			name:        "habits",
+			values:      map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "habit")},
+			wantQueries: []string{"habits"},
+			wantIDs:     []string{"habit-uuid-1"},
+		},
+		{
+			name:        "dailies",
+			values:      map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "daily")},
+			wantQueries: []string{"dailys"},
+			wantIDs:     []string{"daily-uuid-1"},
+		},
+		{
+			name:        "open todos",
+			values:      map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "todo")},
+			wantQueries: []string{"todos"},
+			wantIDs:     []string{"todo-uuid-1"},
+		},
+		{
+			name: "completed todos",
+			values: map[string]tftypes.Value{
+				"type":      tftypes.NewValue(tftypes.String, "todo"),
+				"completed": tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantQueries: []string{"todos", "completedTodos"},
+			wantIDs:     []string{"todo-uuid-done"},
+		},
+		{
+			name:        "rewards",
+			values:      map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "reward")},
+			wantQueries: []string{"rewards"},
+			wantIDs:     []string{"reward-uuid-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queries []string
+			server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+				"/tasks/user": func(w http.ResponseWriter, r *http.Request) {
+					query := r.URL.Query().Get("type")
+					queries = append(queries, query)
+					w.Header().Set("Content-Type", "application/json")
+					w.Write(testutil.MockTasksResponse(byQuery[query]))
+				},
+				"/tags": func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					w.Write(testutil.MockTagsResponse([]client.Tag{testutil.TestTag1, testutil.TestTag2, testutil.TestTag3}))
+				},
+			})
+			defer server.Close()
+
+			d := &tasksDataSource{client: testutil.NewTestClient(server.URL)}
+
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+			attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, typ := range objectType.AttributeTypes {
+				attrs[name] = tftypes.NewValue(typ, nil)
+			}
+			for name, v := range tt.values {
+				attrs[name] = v
+			}
+			raw := tftypes.NewValue(objectType, attrs)
+
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var state tasksModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			var ids []string
+			for _, task := range state.Tasks {
+				ids = append(ids, task.ID.ValueString())
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantQueries, queries)
+		})
+	}
+}
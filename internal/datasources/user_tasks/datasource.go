@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/datasources/tags"
 )
 
 var (
@@ -70,7 +71,8 @@ func (d *userTasksDataSource) Metadata(ctx context.Context, req datasource.Metad
 
 func (d *userTasksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches all tasks (dailies, habits, todos) for the authenticated user with resolved tag names.",
+		Description:        "Fetches all tasks (dailies, habits, todos) for the authenticated user with resolved tag names.",
+		DeprecationMessage: "Use the habitica_tasks data source, which returns typed attributes instead of a JSON string.",
 		Attributes: map[string]schema.Attribute{
 			"json": schema.StringAttribute{
 				Description: "JSON output containing dailies, habits, and todos with resolved tag names.",
@@ -106,18 +108,12 @@ func (d *userTasksDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Fetch all tags for UUID → name resolution
-	tags, err := d.client.GetAllTags(ctx)
+	tagMap, err := tags.Names(ctx, d.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching tags", err.Error())
 		return
 	}
 
-	// Build tag UUID → name map
-	tagMap := make(map[string]string)
-	for _, tag := range tags {
-		tagMap[tag.ID] = tag.Name
-	}
-
 	// Categorize and transform tasks
 	output := tasksOutput{
 		Dailies: []dailyOutput{},
@@ -126,13 +122,7 @@ func (d *userTasksDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	for _, task := range tasks {
-		// Resolve tag UUIDs to names
-		resolvedTags := make([]string, 0, len(task.Tags))
-		for _, tagID := range task.Tags {
-			if name, ok := tagMap[tagID]; ok {
-				resolvedTags = append(resolvedTags, name)
-			}
-		}
+		resolvedTags := tags.Resolve(task.Tags, tagMap)
 
 		switch task.Type {
 		case "daily":
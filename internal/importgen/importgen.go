@@ -0,0 +1,380 @@
+// Package importgen generates Terraform configuration that adopts the tags,
+// tasks and webhooks of an existing Habitica account: import blocks plus the
+// matching resource definitions.
+package importgen
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/stat"
+)
+
+// maxNameLength bounds generated resource names, which are derived from
+// free-form task text.
+const maxNameLength = 50
+
+// Output is the generated configuration.
+type Output struct {
+	// Imports holds the import blocks. They are only needed for the first
+	// apply and can be deleted afterwards.
+	Imports string
+	// Resources holds the resource definitions to keep.
+	Resources string
+}
+
+// Generate fetches the account's tags, habits, dailies, active todos and
+// webhooks and returns the configuration to import them. Task tags refer to
+// the generated tag resources rather than hard-coding their IDs.
+func Generate(ctx context.Context, c *client.Client) (*Output, error) {
+	tags, err := c.GetAllTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching tags: %w", err)
+	}
+
+	tasks := make(map[string][]client.Task)
+	for _, taskType := range []string{"habit", "daily", "todo"} {
+		tasks[taskType], err = c.ListTasks(ctx, client.ListTasksOptions{Type: taskType})
+		if err != nil {
+			return nil, fmt.Errorf("fetching %s tasks: %w", taskType, err)
+		}
+	}
+
+	webhooks, err := c.GetWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching webhooks: %w", err)
+	}
+
+	g := newGenerator()
+	g.header()
+
+	g.section("TAGS")
+	for _, tag := range tags {
+		name := g.resource("habitica_tag", tag.Name, tag.ID, func(b *body) {
+			b.attr("name", quote(tag.Name))
+		})
+		g.tagRefs[tag.ID] = "habitica_tag." + name + ".id"
+	}
+
+	g.section("HABITS")
+	for _, task := range tasks["habit"] {
+		g.resource("habitica_habit", task.Text, task.ID, func(b *body) {
+			b.attr("text", quote(task.Text))
+			if task.Alias != nil && *task.Alias != "" {
+				b.attr("alias", quote(*task.Alias))
+			}
+			if task.Notes != "" {
+				b.attr("notes", quote(task.Notes))
+			}
+			b.attr("up", strconv.FormatBool(task.Up == nil || *task.Up))
+			b.attr("down", strconv.FormatBool(task.Down != nil && *task.Down))
+			if task.Frequency != "" && task.Frequency != "daily" {
+				b.attr("frequency", quote(task.Frequency))
+			}
+			b.attr("priority", formatFloat(task.Priority))
+			if task.Attribute != "" && task.Attribute != stat.Default {
+				b.attr("attribute", quote(task.Attribute))
+			}
+			g.tags(b, task.Tags)
+		})
+	}
+
+	g.section("DAILIES")
+	for _, task := range tasks["daily"] {
+		g.resource("habitica_daily", task.Text, task.ID, func(b *body) {
+			b.attr("text", quote(task.Text))
+			if task.Alias != nil && *task.Alias != "" {
+				b.attr("alias", quote(*task.Alias))
+			}
+			if task.Notes != "" {
+				b.attr("notes", quote(task.Notes))
+			}
+			b.attr("priority", formatFloat(task.Priority))
+			if task.Attribute != "" && task.Attribute != stat.Default {
+				b.attr("attribute", quote(task.Attribute))
+			}
+			b.attr("frequency", quote(task.Frequency))
+			b.attr("every_x", strconv.Itoa(task.EveryX))
+			if task.StartDate != nil {
+				b.attr("start_date", quote(task.StartDate.Format("2006-01-02")))
+			}
+			if task.Repeat != nil && task.Frequency == "weekly" {
+				b.attr("repeat", repeat(task.Repeat))
+			}
+			if len(task.DaysOfMonth) > 0 {
+				b.attr("days_of_month", intList(task.DaysOfMonth))
+			}
+			if len(task.WeeksOfMonth) > 0 {
+				b.attr("weeks_of_month", intList(task.WeeksOfMonth))
+			}
+			g.tags(b, task.Tags)
+			checklist(b, task.Checklist)
+			if len(task.Reminders) > 0 {
+				items := make([]string, len(task.Reminders))
+				for i, rem := range task.Reminders {
+					items[i] = "time = " + quote(rem.Time.UTC().Format("15:04"))
+					if rem.StartDate != nil {
+						items[i] += ", start_date = " + quote(rem.StartDate.UTC().Format("2006-01-02"))
+					}
+				}
+				b.attr("reminders", objectList(items))
+			}
+		})
+	}
+
+	g.section("TODOS")
+	for _, task := range tasks["todo"] {
+		g.resource("habitica_todo", task.Text, task.ID, func(b *body) {
+			b.attr("text", quote(task.Text))
+			if task.Notes != "" {
+				b.attr("notes", quote(task.Notes))
+			}
+			b.attr("priority", formatFloat(task.Priority))
+			if task.Attribute != "" && task.Attribute != stat.Default {
+				b.attr("attribute", quote(task.Attribute))
+			}
+			if task.Date != nil {
+				b.attr("date", quote(task.Date.Format("2006-01-02")))
+			}
+			g.tags(b, task.Tags)
+			checklist(b, task.Checklist)
+		})
+	}
+
+	g.section("WEBHOOKS")
+	for _, webhook := range webhooks {
+		label := webhook.Label
+		if label == "" {
+			label = webhook.Type
+		}
+		g.resource("habitica_webhook", label, webhook.ID, func(b *body) {
+			b.attr("url", quote(webhook.URL))
+			if webhook.Label != "" {
+				b.attr("label", quote(webhook.Label))
+			}
+			b.attr("type", quote(webhook.Type))
+			b.attr("enabled", strconv.FormatBool(webhook.Enabled))
+			if webhook.Type == "taskActivity" {
+				o := webhook.Options
+				b.attr("options", fmt.Sprintf("{ created = %t, updated = %t, deleted = %t, scored = %t, checklist_scored = %t }",
+					o.Created, o.Updated, o.Deleted, o.Scored, o.ChecklistScored))
+			}
+		})
+	}
+
+	return &Output{Imports: g.imports.String(), Resources: g.resources.String()}, nil
+}
+
+type generator struct {
+	imports   strings.Builder
+	resources strings.Builder
+	// names holds the resource names already used for each resource type
+	names map[string]map[string]bool
+	// tagRefs maps tag IDs to references to their generated resources
+	tagRefs map[string]string
+}
+
+func newGenerator() *generator {
+	return &generator{
+		names:   make(map[string]map[string]bool),
+		tagRefs: make(map[string]string),
+	}
+}
+
+func (g *generator) header() {
+	g.imports.WriteString("# TEMPORARY: Delete this file after running terraform apply\n\n")
+
+	g.resources.WriteString(`terraform {
+  required_providers {
+    habitica = { source = "registry.terraform.io/inannamalick/habitica" }
+  }
+}
+
+provider "habitica" {}
+`)
+}
+
+func (g *generator) section(title string) {
+	fmt.Fprintf(&g.resources, "\n# === %s ===\n", title)
+}
+
+// resource writes the import and resource blocks for one object and returns
+// the resource name it was given.
+func (g *generator) resource(resourceType, label, id string, fill func(b *body)) string {
+	name := g.name(resourceType, label)
+
+	fmt.Fprintf(&g.imports, "import {\n  to = %s.%s\n  id = %s\n}\n\n", resourceType, name, quote(id))
+
+	b := &body{}
+	fill(b)
+	fmt.Fprintf(&g.resources, "\nresource %q %q {\n", resourceType, name)
+	b.writeTo(&g.resources)
+	g.resources.WriteString("}\n")
+
+	return name
+}
+
+// name derives a valid resource name from label. Names are unique per
+// resource type: later objects with the same label get a numeric suffix, so
+// names stay stable as long as the account's objects keep their order.
+func (g *generator) name(resourceType, label string) string {
+	used := g.names[resourceType]
+	if used == nil {
+		used = make(map[string]bool)
+		g.names[resourceType] = used
+	}
+
+	base := sanitize(label)
+	if base == "" {
+		base = strings.TrimPrefix(resourceType, "habitica_")
+	}
+
+	name := base
+	for i := 2; used[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[name] = true
+	return name
+}
+
+// tags writes the tags attribute, referring to generated tag resources where
+// possible.
+func (g *generator) tags(b *body, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+
+	refs := make([]string, len(ids))
+	for i, id := range ids {
+		if ref, ok := g.tagRefs[id]; ok {
+			refs[i] = ref
+		} else {
+			refs[i] = quote(id)
+		}
+	}
+	b.attr("tags", "["+strings.Join(refs, ", ")+"]")
+}
+
+// sanitize lower-cases label and replaces everything but letters and digits
+// with single underscores. Names cannot start with a digit, so those get a
+// leading underscore.
+func sanitize(label string) string {
+	var sb strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(label) {
+		if ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			if underscore && sb.Len() > 0 {
+				sb.WriteByte('_')
+			}
+			sb.WriteRune(r)
+			underscore = false
+		} else {
+			underscore = true
+		}
+	}
+
+	name := sb.String()
+	if len(name) > maxNameLength {
+		name = strings.TrimRight(name[:maxNameLength], "_")
+	}
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// body collects the attributes of a block so that their equals signs can be
+// aligned the way terraform fmt does.
+type body struct {
+	keys   []string
+	values []string
+}
+
+func (b *body) attr(key, value string) {
+	b.keys = append(b.keys, key)
+	b.values = append(b.values, value)
+}
+
+func (b *body) writeTo(sb *strings.Builder) {
+	width := 0
+	for _, key := range b.keys {
+		width = max(width, len(key))
+	}
+	for i, key := range b.keys {
+		fmt.Fprintf(sb, "  %-*s = %s\n", width, key, b.values[i])
+	}
+}
+
+func checklist(b *body, items []client.ChecklistItem) {
+	if len(items) == 0 {
+		return
+	}
+
+	objects := make([]string, len(items))
+	for i, item := range items {
+		objects[i] = "text = " + quote(item.Text)
+	}
+	b.attr("checklist", objectList(objects))
+}
+
+// objectList formats a list of objects, one per line, from their attributes.
+func objectList(objects []string) string {
+	var sb strings.Builder
+	sb.WriteString("[\n")
+	for _, o := range objects {
+		fmt.Fprintf(&sb, "    { %s },\n", o)
+	}
+	sb.WriteString("  ]")
+	return sb.String()
+}
+
+func repeat(r *client.RepeatConfig) string {
+	return fmt.Sprintf("{ monday = %t, tuesday = %t, wednesday = %t, thursday = %t, friday = %t, saturday = %t, sunday = %t }",
+		r.Monday, r.Tuesday, r.Wednesday, r.Thursday, r.Friday, r.Saturday, r.Sunday)
+}
+
+func intList(values []int) string {
+	items := make([]string, len(values))
+	for i, v := range values {
+		items[i] = strconv.Itoa(v)
+	}
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// quote returns s as an HCL string literal. Template sequences are escaped
+// so that text such as "${name}" is kept literally.
+func quote(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '"':
+			sb.WriteString(`\"`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			sb.WriteRune(r)
+			sb.WriteRune(r)
+		case r < 0x20 || r == 0x7f || r == utf8.RuneError:
+			fmt.Fprintf(&sb, `\u%04X`, r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
@@ -0,0 +1,228 @@
+package importgen
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	startDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	tasks := map[string][]client.Task{
+		"habits": {
+			{ID: "habit-1", Type: "habit", Text: "Drink water", Up: testutil.BoolPtr(true), Down: testutil.BoolPtr(false), Priority: 1, Tags: []string{"tag-1", "tag-unknown"}},
+			{ID: "habit-2", Type: "habit", Text: "Drink  Water!", Notes: `Say "${hi}"`, Up: testutil.BoolPtr(true), Down: testutil.BoolPtr(true), Frequency: "weekly", Priority: 0.1},
+		},
+		"dailys": {
+			{
+				ID: "daily-1", Type: "daily", Text: "Stretch", Alias: testutil.StringPtr("stretch"), Priority: 1.5, Frequency: "weekly", EveryX: 1, StartDate: &startDate,
+				Repeat:    &client.RepeatConfig{Monday: true, Wednesday: true, Friday: true},
+				Tags:      []string{"tag-2"},
+				Checklist: []client.ChecklistItem{{ID: "item-1", Text: "Neck"}, {ID: "item-2", Text: "Back", Completed: true}},
+			},
+		},
+		"todos": {
+			{ID: "todo-1", Type: "todo", Text: "2024 taxes", Priority: 2, Attribute: "int"},
+		},
+	}
+
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tags": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write(testutil.MockTagsResponse([]client.Tag{
+				{ID: "tag-1", Name: "Health"},
+				{ID: "tag-2", Name: "🏃"},
+			}))
+		},
+		"/tasks/user": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write(testutil.MockTasksResponse(tasks[r.URL.Query().Get("type")]))
+		},
+		"/user/webhook": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write(testutil.MockWebhooksResponse([]client.Webhook{
+				{ID: "webhook-1", URL: "https://example.com/hook", Type: "taskActivity", Enabled: true, Options: client.WebhookOptions{Scored: true}},
+			}))
+		},
+	})
+	defer server.Close()
+
+	out, err := Generate(context.Background(), testutil.NewTestClient(server.URL))
+	require.NoError(t, err)
+
+	assert.Equal(t, `# TEMPORARY: Delete this file after running terraform apply
+
+import {
+  to = habitica_tag.health
+  id = "tag-1"
+}
+
+import {
+  to = habitica_tag.tag
+  id = "tag-2"
+}
+
+import {
+  to = habitica_habit.drink_water
+  id = "habit-1"
+}
+
+import {
+  to = habitica_habit.drink_water_2
+  id = "habit-2"
+}
+
+import {
+  to = habitica_daily.stretch
+  id = "daily-1"
+}
+
+import {
+  to = habitica_todo._2024_taxes
+  id = "todo-1"
+}
+
+import {
+  to = habitica_webhook.taskactivity
+  id = "webhook-1"
+}
+
+`, out.Imports)
+
+	assert.Equal(t, `terraform {
+  required_providers {
+    habitica = { source = "registry.terraform.io/inannamalick/habitica" }
+  }
+}
+
+provider "habitica" {}
+
+# === TAGS ===
+
+resource "habitica_tag" "health" {
+  name = "Health"
+}
+
+resource "habitica_tag" "tag" {
+  name = "🏃"
+}
+
+# === HABITS ===
+
+resource "habitica_habit" "drink_water" {
+  text     = "Drink water"
+  up       = true
+  down     = false
+  priority = 1
+  tags     = [habitica_tag.health.id, "tag-unknown"]
+}
+
+resource "habitica_habit" "drink_water_2" {
+  text      = "Drink  Water!"
+  notes     = "Say \"$${hi}\""
+  up        = true
+  down      = true
+  frequency = "weekly"
+  priority  = 0.1
+}
+
+# === DAILIES ===
+
+resource "habitica_daily" "stretch" {
+  text       = "Stretch"
+  alias      = "stretch"
+  priority   = 1.5
+  frequency  = "weekly"
+  every_x    = 1
+  start_date = "2024-01-15"
+  repeat     = { monday = true, tuesday = false, wednesday = true, thursday = false, friday = true, saturday = false, sunday = false }
+  tags       = [habitica_tag.tag.id]
+  checklist  = [
+    { text = "Neck" },
+    { text = "Back" },
+  ]
+}
+
+# === TODOS ===
+
+resource "habitica_todo" "_2024_taxes" {
+  text      = "2024 taxes"
+  priority  = 2
+  attribute = "int"
+}
+
+# === WEBHOOKS ===
+
+resource "habitica_webhook" "taskactivity" {
+  url     = "https://example.com/hook"
+  type    = "taskActivity"
+  enabled = true
+  options = { created = false, updated = false, deleted = false, scored = true, checklist_scored = false }
+}
+`, out.Resources)
+}
+
+func TestGenerateError(t *testing.T) {
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tags": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write(testutil.MockErrorResponse(http.StatusUnauthorized, "Missing authentication headers."))
+		},
+	})
+	defer server.Close()
+
+	_, err := Generate(context.Background(), testutil.NewTestClient(server.URL))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, client.ErrUnauthorized)
+	assert.Contains(t, err.Error(), "fetching tags")
+}
+
+func TestSanitize(t *testing.T) {
+	tests := map[string]string{
+		"Morning Run":       "morning_run",
+		"  --Read, a book!": "read_a_book",
+		"10k steps":         "_10k_steps",
+		"Éclair":            "clair",
+		"🏃":                 "",
+		"a_b":               "a_b",
+		"":                  "",
+	}
+	for label, want := range tests {
+		assert.Equal(t, want, sanitize(label), "%q", label)
+	}
+
+	long := sanitize("abcdefghij abcdefghij abcdefghij abcdefghij abcdefghij abcdefghij")
+	assert.LessOrEqual(t, len(long), maxNameLength)
+	assert.NotEqual(t, '_', rune(long[len(long)-1]))
+}
+
+func TestQuote(t *testing.T) {
+	tests := map[string]string{
+		`plain`:       `"plain"`,
+		`say "hi"`:    `"say \"hi\""`,
+		`back\slash`:  `"back\\slash"`,
+		"two\nlines":  `"two\nlines"`,
+		"${var}":      `"$${var}"`,
+		"%{if}":       `"%%{if}"`,
+		"$5 and 100%": `"$5 and 100%"`,
+		"bell\a":      `"bell\u0007"`,
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, quote(in), "%q", in)
+	}
+}
+
+func TestNameCollisions(t *testing.T) {
+	g := newGenerator()
+	assert.Equal(t, "walk", g.name("habitica_habit", "Walk"))
+	assert.Equal(t, "walk_2", g.name("habitica_habit", "walk_2"))
+	assert.Equal(t, "walk_3", g.name("habitica_habit", "WALK"))
+	assert.Equal(t, "walk", g.name("habitica_daily", "Walk"), "names are unique per resource type")
+	assert.Equal(t, "habit", g.name("habitica_habit", "!!"))
+}
@@ -2,19 +2,28 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/datasources/tags"
+	"github.com/inannamalick/terraform-provider-habitica/internal/datasources/tasks"
 	"github.com/inannamalick/terraform-provider-habitica/internal/datasources/user_tasks"
 	"github.com/inannamalick/terraform-provider-habitica/internal/resources/daily"
 	"github.com/inannamalick/terraform-provider-habitica/internal/resources/habit"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/reward"
 	"github.com/inannamalick/terraform-provider-habitica/internal/resources/tag"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/todo"
 	"github.com/inannamalick/terraform-provider-habitica/internal/resources/webhook"
 )
 
@@ -27,11 +36,20 @@ type HabiticaProvider struct {
 
 // HabiticaProviderModel describes the provider data model.
 type HabiticaProviderModel struct {
-	UserID          types.String `tfsdk:"user_id"`
-	APIToken        types.String `tfsdk:"api_token"`
-	ClientAuthorID  types.String `tfsdk:"client_author_id"`
-	ClientAppName   types.String `tfsdk:"client_app_name"`
-	RateLimitBuffer types.Int64  `tfsdk:"rate_limit_buffer"`
+	UserID                types.String  `tfsdk:"user_id"`
+	APIToken              types.String  `tfsdk:"api_token"`
+	ClientAuthorID        types.String  `tfsdk:"client_author_id"`
+	ClientAppName         types.String  `tfsdk:"client_app_name"`
+	RateLimitBuffer       types.Int64   `tfsdk:"rate_limit_buffer"`
+	RequestsPerMinute     types.Int64   `tfsdk:"requests_per_minute"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	LogHTTPBodies         types.Bool    `tfsdk:"log_http_bodies"`
+	BaseURL               types.String  `tfsdk:"base_url"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	RetryBaseDelay        types.String  `tfsdk:"retry_base_delay"`
+	RetryJitter           types.Float64 `tfsdk:"retry_jitter"`
+	RequestTimeout        types.String  `tfsdk:"request_timeout"`
+	CacheTTL              types.String  `tfsdk:"cache_ttl"`
 }
 
 // New returns a new provider instance.
@@ -50,7 +68,7 @@ func (p *HabiticaProvider) Metadata(ctx context.Context, req provider.MetadataRe
 
 func (p *HabiticaProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Terraform provider for managing Habitica habits, dailies, tags, and webhooks.",
+		Description: "Terraform provider for managing Habitica habits, dailies, todos, rewards, tags, and webhooks.",
 		Attributes: map[string]schema.Attribute{
 			"user_id": schema.StringAttribute{
 				Description: "Habitica user ID (UUID). Can also be set via HABITICA_USER_ID environment variable.",
@@ -73,6 +91,42 @@ func (p *HabiticaProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Description: "Number of remaining requests at which to pause and wait for rate limit reset. Defaults to 5.",
 				Optional:    true,
 			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "Maximum number of API requests per minute, shared by all resources in a run. Defaults to 30, Habitica's limit.",
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of API requests in flight at once. Defaults to 4.",
+				Optional:    true,
+			},
+			"log_http_bodies": schema.BoolAttribute{
+				Description: "Include request and response bodies in debug logs (TF_LOG=DEBUG). Bodies contain your task data. Defaults to false.",
+				Optional:    true,
+			},
+			"base_url": schema.StringAttribute{
+				Description: "Base URL of the Habitica API, for self-hosted instances. Defaults to 'https://habitica.com/api/v3'. Can also be set via HABITICA_BASE_URL environment variable.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Number of times a rate limited or failed request is retried. Set to 0 to disable retries. Defaults to 5. Can also be set via HABITICA_MAX_RETRIES environment variable.",
+				Optional:    true,
+			},
+			"retry_base_delay": schema.StringAttribute{
+				Description: "Delay before the first retry, doubled on each further attempt, as a Go duration such as '2s' or '500ms'. Defaults to '2s'. Can also be set via HABITICA_RETRY_BASE_DELAY environment variable.",
+				Optional:    true,
+			},
+			"retry_jitter": schema.Float64Attribute{
+				Description: "Up to this fraction of each retry delay is added at random, so that parallel requests do not retry in lockstep. Set to 0 to disable. Defaults to 0.2. Can also be set via HABITICA_RETRY_JITTER environment variable.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout for a single HTTP request, as a Go duration such as '30s'. Defaults to '30s'. Can also be set via HABITICA_REQUEST_TIMEOUT environment variable.",
+				Optional:    true,
+			},
+			"cache_ttl": schema.StringAttribute{
+				Description: "How long tasks and tags fetched in bulk are reused before being fetched again, as a Go duration such as '5m'. Defaults to '5m'. Can also be set via HABITICA_CACHE_TTL environment variable.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -110,6 +164,83 @@ func (p *HabiticaProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if !config.RequestsPerMinute.IsNull() && config.RequestsPerMinute.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_minute"),
+			"Invalid Requests Per Minute",
+			fmt.Sprintf("requests_per_minute must be at least 1, got: %d", config.RequestsPerMinute.ValueInt64()),
+		)
+	}
+
+	if !config.MaxConcurrentRequests.IsNull() && config.MaxConcurrentRequests.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid Max Concurrent Requests",
+			fmt.Sprintf("max_concurrent_requests must be at least 1, got: %d", config.MaxConcurrentRequests.ValueInt64()),
+		)
+	}
+
+	if !config.RateLimitBuffer.IsNull() {
+		limit := int64(client.DefaultRequestsPerMinute)
+		if !config.RequestsPerMinute.IsNull() {
+			limit = config.RequestsPerMinute.ValueInt64()
+		}
+		if config.RateLimitBuffer.ValueInt64() >= limit {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rate_limit_buffer"),
+				"Invalid Rate Limit Buffer",
+				fmt.Sprintf("rate_limit_buffer must be lower than requests_per_minute (%d), otherwise no request can ever be sent.", limit),
+			)
+		}
+	}
+
+	baseURL := getConfigOrEnv(config.BaseURL, "HABITICA_BASE_URL")
+	if baseURL != "" {
+		if u, err := url.Parse(baseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("base_url"),
+				"Invalid Base URL",
+				fmt.Sprintf("base_url must be an absolute http or https URL, got: %q", baseURL),
+			)
+		}
+	}
+
+	maxRetries := 0
+	if value, ok := getInt64ConfigOrEnv(config.MaxRetries, "HABITICA_MAX_RETRIES", path.Root("max_retries"), &resp.Diagnostics); ok {
+		if value < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				fmt.Sprintf("max_retries must not be negative, got: %d", value),
+			)
+		}
+		// The client treats zero as unset
+		maxRetries = int(value)
+		if maxRetries == 0 {
+			maxRetries = -1
+		}
+	}
+
+	retryJitter := 0.0
+	if value, ok := getFloat64ConfigOrEnv(config.RetryJitter, "HABITICA_RETRY_JITTER", path.Root("retry_jitter"), &resp.Diagnostics); ok {
+		if value < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_jitter"),
+				"Invalid Retry Jitter",
+				fmt.Sprintf("retry_jitter must not be negative, got: %s", strconv.FormatFloat(value, 'f', -1, 64)),
+			)
+		}
+		// The client treats zero as unset
+		retryJitter = value
+		if retryJitter == 0 {
+			retryJitter = -1
+		}
+	}
+
+	retryBaseDelay := getDurationConfigOrEnv(config.RetryBaseDelay, "HABITICA_RETRY_BASE_DELAY", path.Root("retry_base_delay"), &resp.Diagnostics)
+	requestTimeout := getDurationConfigOrEnv(config.RequestTimeout, "HABITICA_REQUEST_TIMEOUT", path.Root("request_timeout"), &resp.Diagnostics)
+	cacheTTL := getDurationConfigOrEnv(config.CacheTTL, "HABITICA_CACHE_TTL", path.Root("cache_ttl"), &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -124,13 +255,31 @@ func (p *HabiticaProvider) Configure(ctx context.Context, req provider.Configure
 		rateLimitBuffer = int(config.RateLimitBuffer.ValueInt64())
 	}
 
+	requestsPerMinute := 0
+	if !config.RequestsPerMinute.IsNull() {
+		requestsPerMinute = int(config.RequestsPerMinute.ValueInt64())
+	}
+
+	maxConcurrentRequests := 0
+	if !config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+
 	c := client.New(client.Config{
-		UserID:          userID,
-		APIKey:          apiToken,
-		ClientAuthorID:  clientAuthorID,
-		ClientAppName:   clientAppName,
-		RateLimitBuffer: rateLimitBuffer,
-		BaseRetryDelay:  2 * time.Second,
+		UserID:                userID,
+		APIKey:                apiToken,
+		ClientAuthorID:        clientAuthorID,
+		ClientAppName:         clientAppName,
+		RateLimitBuffer:       rateLimitBuffer,
+		RequestsPerMinute:     requestsPerMinute,
+		MaxConcurrentRequests: maxConcurrentRequests,
+		LogBodies:             config.LogHTTPBodies.ValueBool(),
+		BaseURL:               baseURL,
+		MaxRetries:            maxRetries,
+		BaseRetryDelay:        retryBaseDelay,
+		RetryJitter:           retryJitter,
+		RequestTimeout:        requestTimeout,
+		CacheTTL:              cacheTTL,
 	})
 
 	resp.DataSourceData = c
@@ -144,11 +293,81 @@ func getConfigOrEnv(configValue types.String, envVar string) string {
 	return os.Getenv(envVar)
 }
 
+// getInt64ConfigOrEnv returns the configured value or the parsed environment
+// variable, and whether either was set.
+func getInt64ConfigOrEnv(configValue types.Int64, envVar string, attrPath path.Path, diags *diag.Diagnostics) (int64, bool) {
+	if !configValue.IsNull() {
+		return configValue.ValueInt64(), true
+	}
+
+	env := os.Getenv(envVar)
+	if env == "" {
+		return 0, false
+	}
+
+	value, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Environment Variable",
+			fmt.Sprintf("%s must be an integer, got: %q", envVar, env),
+		)
+		return 0, false
+	}
+	return value, true
+}
+
+// getFloat64ConfigOrEnv returns the configured value or the parsed environment
+// variable, and whether either was set.
+func getFloat64ConfigOrEnv(configValue types.Float64, envVar string, attrPath path.Path, diags *diag.Diagnostics) (float64, bool) {
+	if !configValue.IsNull() {
+		return configValue.ValueFloat64(), true
+	}
+
+	env := os.Getenv(envVar)
+	if env == "" {
+		return 0, false
+	}
+
+	value, err := strconv.ParseFloat(env, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Environment Variable",
+			fmt.Sprintf("%s must be a number, got: %q", envVar, env),
+		)
+		return 0, false
+	}
+	return value, true
+}
+
+// getDurationConfigOrEnv parses a duration from the configuration or the
+// environment variable. It returns zero if neither is set.
+func getDurationConfigOrEnv(configValue types.String, envVar string, attrPath path.Path, diags *diag.Diagnostics) time.Duration {
+	raw := getConfigOrEnv(configValue, envVar)
+	if raw == "" {
+		return 0
+	}
+
+	value, err := time.ParseDuration(raw)
+	if err != nil || value <= 0 {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a positive duration such as '2s' or '500ms' (from the configuration or %s), got: %q", envVar, raw),
+		)
+		return 0
+	}
+	return value
+}
+
 func (p *HabiticaProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		tag.NewResource,
 		habit.NewResource,
 		daily.NewResource,
+		todo.NewResource,
+		reward.NewResource,
 		webhook.NewResource,
 	}
 }
@@ -156,5 +375,9 @@ func (p *HabiticaProvider) Resources(ctx context.Context) []func() resource.Reso
 func (p *HabiticaProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		user_tasks.NewDataSource,
+		tags.NewTagsDataSource,
+		tags.NewTagDataSource,
+		tasks.NewTasksDataSource,
+		tasks.NewTaskDataSource,
 	}
 }
@@ -0,0 +1,109 @@
+// Package alias holds the alias attribute shared by the habit and daily
+// resources. An alias is a user-chosen, stable name that the Habitica API
+// accepts wherever it takes a task ID.
+package alias
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+)
+
+var (
+	aliasPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	uuidPattern  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// Attribute returns the alias schema attribute for the given task type.
+func Attribute(taskType string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("A short, unique name for the %s that can be used instead of its ID, e.g. to score it through the API. "+
+			"May contain letters, digits, hyphens and underscores.", taskType),
+		Optional: true,
+		Validators: []validator.String{
+			aliasValidator{},
+		},
+	}
+}
+
+// ToClient converts the planned alias into the value to send. Removing the
+// alias from the configuration sends it as empty, which clears it.
+func ToClient(planned, prior types.String) *string {
+	if planned.IsNull() || planned.IsUnknown() {
+		if prior.IsNull() || prior.IsUnknown() {
+			return nil
+		}
+		empty := ""
+		return &empty
+	}
+
+	alias := planned.ValueString()
+	return &alias
+}
+
+// FromTask returns the task's alias as a model value.
+func FromTask(task *client.Task) types.String {
+	if task.Alias == nil || *task.Alias == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*task.Alias)
+}
+
+// CheckAvailable returns an error if another task than the one with ID
+// taskID already uses the alias. Habitica rejects duplicate aliases with a
+// generic validation error, so this names the task that holds it instead.
+func CheckAvailable(ctx context.Context, c *client.Client, value types.String, taskID string) error {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return nil
+	}
+
+	owner, err := c.GetTask(ctx, value.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if owner.ID != taskID {
+		return fmt.Errorf("alias %q is already used by %s %q (%s)", value.ValueString(), owner.Type, owner.Text, owner.ID)
+	}
+	return nil
+}
+
+type aliasValidator struct{}
+
+func (v aliasValidator) Description(ctx context.Context) string {
+	return "value must contain only letters, digits, hyphens and underscores, and must not be a UUID"
+}
+
+func (v aliasValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v aliasValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	switch {
+	case !aliasPattern.MatchString(value):
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Alias",
+			fmt.Sprintf("An alias may only contain letters, digits, hyphens and underscores, got: %q", value),
+		)
+	case uuidPattern.MatchString(value):
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Alias",
+			fmt.Sprintf("An alias cannot be a UUID, as it would be mistaken for a task ID, got: %q", value),
+		)
+	}
+}
@@ -0,0 +1,64 @@
+package alias
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAliasValidator(t *testing.T) {
+	for value, valid := range map[string]bool{
+		"drink-water":                          true,
+		"morning_run":                          true,
+		"Run5k":                                true,
+		"":                                     false,
+		"drink water":                          false,
+		"drink.water":                          false,
+		"café":                                 false,
+		"0b8a1c3e-5d2f-4e6a-9b7c-1d2e3f4a5b6c": false,
+	} {
+		req := validator.StringRequest{Path: path.Root("alias"), ConfigValue: types.StringValue(value)}
+		resp := &validator.StringResponse{}
+		aliasValidator{}.ValidateString(context.Background(), req, resp)
+		assert.Equal(t, !valid, resp.Diagnostics.HasError(), "%q", value)
+	}
+}
+
+func TestToClient(t *testing.T) {
+	assert.Nil(t, ToClient(types.StringNull(), types.StringNull()), "unset alias is left alone")
+	assert.Equal(t, "", *ToClient(types.StringNull(), types.StringValue("old")), "removed alias is cleared")
+	assert.Equal(t, "new", *ToClient(types.StringValue("new"), types.StringValue("old")))
+}
+
+func TestCheckAvailable(t *testing.T) {
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tasks/user": func(w http.ResponseWriter, r *http.Request) {
+			var tasks []client.Task
+			if r.URL.Query().Get("type") == "" {
+				tasks = []client.Task{{ID: "habit-1", Type: "habit", Text: "Drink water", Alias: testutil.StringPtr("drink-water")}}
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write(testutil.MockTasksResponse(tasks))
+		},
+	})
+	defer server.Close()
+
+	c := testutil.NewTestClient(server.URL)
+	ctx := context.Background()
+
+	assert.NoError(t, CheckAvailable(ctx, c, types.StringNull(), ""))
+	assert.NoError(t, CheckAvailable(ctx, c, types.StringValue("stretch"), ""))
+	assert.NoError(t, CheckAvailable(ctx, c, types.StringValue("drink-water"), "habit-1"), "a task keeps its own alias")
+
+	err := CheckAvailable(ctx, c, types.StringValue("drink-water"), "")
+	require.Error(t, err)
+	assert.Equal(t, `alias "drink-water" is already used by habit "Drink water" (habit-1)`, err.Error())
+}
@@ -0,0 +1,157 @@
+// Package checklist holds the checklist schema and conversions shared by the
+// daily and todo resources.
+package checklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+)
+
+// ItemModel describes a single checklist item.
+type ItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Text      types.String `tfsdk:"text"`
+	Completed types.Bool   `tfsdk:"completed"`
+}
+
+// ElementType is the object type of a checklist list element.
+var ElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":        types.StringType,
+		"text":      types.StringType,
+		"completed": types.BoolType,
+	},
+}
+
+// Attribute returns the checklist schema attribute for the given task type.
+func Attribute(taskType string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: fmt.Sprintf("Ordered checklist of sub-steps for the %s. If omitted, items managed in the app are left untouched; set to [] to remove all items.", taskType),
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			stablePlanModifier{},
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The unique identifier of the checklist item.",
+					Computed:    true,
+				},
+				"text": schema.StringAttribute{
+					Description: "The text of the checklist item.",
+					Required:    true,
+				},
+				"completed": schema.BoolAttribute{
+					Description: "Whether the checklist item is checked off in Habitica.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// ToItems converts a checklist list value into client items. Null or unknown
+// lists yield nil.
+func ToItems(ctx context.Context, list types.List, diags *diag.Diagnostics) []client.ChecklistItem {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var models []ItemModel
+	diags.Append(list.ElementsAs(ctx, &models, false)...)
+
+	items := make([]client.ChecklistItem, len(models))
+	for i, m := range models {
+		items[i] = client.ChecklistItem{
+			ID:        m.ID.ValueString(),
+			Text:      m.Text.ValueString(),
+			Completed: m.Completed.ValueBool(),
+		}
+	}
+	return items
+}
+
+// FromItems converts client items into a checklist list value. A task without
+// checklist items yields an empty list rather than null.
+func FromItems(ctx context.Context, items []client.ChecklistItem, diags *diag.Diagnostics) types.List {
+	models := make([]ItemModel, len(items))
+	for i, item := range items {
+		models[i] = ItemModel{
+			ID:        types.StringValue(item.ID),
+			Text:      types.StringValue(item.Text),
+			Completed: types.BoolValue(item.Completed),
+		}
+	}
+
+	list, d := types.ListValueFrom(ctx, ElementType, models)
+	diags.Append(d...)
+	return list
+}
+
+// stablePlanModifier predicts which checklist items keep their ID and
+// completion state, using the same matching as client.DiffChecklist. When
+// the checklist is not configured, the current items are kept as-is.
+type stablePlanModifier struct{}
+
+func (m stablePlanModifier) Description(ctx context.Context) string {
+	return "Keeps checklist item IDs and completion state for items that survive the update."
+}
+
+func (m stablePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m stablePlanModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.ConfigValue.IsNull() {
+		if !req.StateValue.IsNull() {
+			resp.PlanValue = req.StateValue
+		}
+		return
+	}
+
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planned []ItemModel
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := make([]client.ChecklistItem, len(planned))
+	for i, p := range planned {
+		if p.Text.IsUnknown() {
+			return
+		}
+		desired[i] = client.ChecklistItem{Text: p.Text.ValueString()}
+	}
+
+	current := ToItems(ctx, req.StateValue, &resp.Diagnostics)
+	diff := client.DiffChecklist(current, desired)
+
+	result := make([]ItemModel, len(diff.Result))
+	for i, item := range diff.Result {
+		result[i] = ItemModel{
+			ID:        types.StringUnknown(),
+			Text:      planned[i].Text,
+			Completed: types.BoolUnknown(),
+		}
+		if item.ID != "" {
+			result[i].ID = types.StringValue(item.ID)
+			result[i].Completed = types.BoolValue(item.Completed)
+		}
+	}
+
+	list, d := types.ListValueFrom(ctx, ElementType, result)
+	resp.Diagnostics.Append(d...)
+	resp.PlanValue = list
+}
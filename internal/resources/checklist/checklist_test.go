@@ -0,0 +1,96 @@
+package checklist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func configList(t *testing.T, texts ...string) types.List {
+	t.Helper()
+
+	models := make([]ItemModel, len(texts))
+	for i, text := range texts {
+		models[i] = ItemModel{
+			ID:        types.StringUnknown(),
+			Text:      types.StringValue(text),
+			Completed: types.BoolUnknown(),
+		}
+	}
+
+	list, d := types.ListValueFrom(context.Background(), ElementType, models)
+	require.False(t, d.HasError())
+	return list
+}
+
+func TestFromItemsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	items := []client.ChecklistItem{
+		{ID: "a", Text: "Brush teeth", Completed: true},
+		{ID: "b", Text: "Shower"},
+	}
+
+	var diags diag.Diagnostics
+	list := FromItems(ctx, items, &diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, items, ToItems(ctx, list, &diags))
+
+	empty := FromItems(ctx, nil, &diags)
+	assert.False(t, empty.IsNull(), "a task without items is an empty list, not null")
+	assert.Empty(t, empty.Elements())
+}
+
+// TestStablePlanModifier validates that surviving items keep their IDs in the plan
+func TestStablePlanModifier(t *testing.T) {
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	state := FromItems(ctx, []client.ChecklistItem{
+		{ID: "a", Text: "Brush teeth", Completed: true},
+		{ID: "b", Text: "Shower"},
+	}, &diags)
+	require.False(t, diags.HasError())
+
+	config := configList(t, "Brush teeth", "Shower", "Meditate")
+	req := planmodifier.ListRequest{ConfigValue: config, PlanValue: config, StateValue: state}
+	resp := &planmodifier.ListResponse{PlanValue: req.PlanValue}
+
+	stablePlanModifier{}.PlanModifyList(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	var planned []ItemModel
+	require.False(t, resp.PlanValue.ElementsAs(ctx, &planned, false).HasError())
+	require.Len(t, planned, 3)
+
+	assert.Equal(t, "a", planned[0].ID.ValueString())
+	assert.True(t, planned[0].Completed.ValueBool())
+	assert.Equal(t, "b", planned[1].ID.ValueString())
+	assert.True(t, planned[2].ID.IsUnknown(), "new items get their ID after apply")
+	assert.True(t, planned[2].Completed.IsUnknown())
+}
+
+// TestStablePlanModifierUnmanaged validates that an omitted checklist keeps the current items
+func TestStablePlanModifierUnmanaged(t *testing.T) {
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	state := FromItems(ctx, []client.ChecklistItem{{ID: "a", Text: "Added in the app"}}, &diags)
+	require.False(t, diags.HasError())
+
+	req := planmodifier.ListRequest{
+		ConfigValue: types.ListNull(ElementType),
+		PlanValue:   types.ListUnknown(ElementType),
+		StateValue:  state,
+	}
+	resp := &planmodifier.ListResponse{PlanValue: req.PlanValue}
+
+	stablePlanModifier{}.PlanModifyList(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, state, resp.PlanValue)
+}
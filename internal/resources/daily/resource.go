@@ -2,6 +2,7 @@ package daily
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,14 +11,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/alias"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/checklist"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/difficulty"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/importid"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/stat"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/timeouts"
 )
 
 var (
@@ -38,8 +45,11 @@ type dailyResource struct {
 type dailyResourceModel struct {
 	ID           types.String  `tfsdk:"id"`
 	Text         types.String  `tfsdk:"text"`
+	Alias        types.String  `tfsdk:"alias"`
 	Notes        types.String  `tfsdk:"notes"`
 	Priority     types.Float64 `tfsdk:"priority"`
+	Difficulty   types.String  `tfsdk:"difficulty"`
+	Attribute    types.String  `tfsdk:"attribute"`
 	Frequency    types.String  `tfsdk:"frequency"`
 	EveryX       types.Int64   `tfsdk:"every_x"`
 	StartDate    types.String  `tfsdk:"start_date"`
@@ -47,6 +57,23 @@ type dailyResourceModel struct {
 	DaysOfMonth  types.List    `tfsdk:"days_of_month"`
 	WeeksOfMonth types.List    `tfsdk:"weeks_of_month"`
 	Tags         types.List    `tfsdk:"tags"`
+	Checklist    types.List    `tfsdk:"checklist"`
+	Reminders    types.List    `tfsdk:"reminders"`
+	Timeouts     types.Object  `tfsdk:"timeouts"`
+}
+
+type reminderModel struct {
+	ID        types.String `tfsdk:"id"`
+	Time      types.String `tfsdk:"time"`
+	StartDate types.String `tfsdk:"start_date"`
+}
+
+var reminderObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":         types.StringType,
+		"time":       types.StringType,
+		"start_date": types.StringType,
+	},
 }
 
 func (r *dailyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,17 +95,18 @@ func (r *dailyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "The title of the daily.",
 				Required:    true,
 			},
+			"alias": alias.Attribute("daily"),
 			"notes": schema.StringAttribute{
 				Description: "Extra notes or description for the daily.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"priority": schema.Float64Attribute{
-				Description: "Difficulty level: 0.1 (trivial), 1 (easy), 1.5 (medium), 2 (hard). Defaults to 1.",
-				Optional:    true,
-				Computed:    true,
-				Default:     float64default.StaticFloat64(1),
-			},
+			"priority":   difficulty.PriorityAttribute("daily"),
+			"difficulty": difficulty.Attribute("daily"),
+			"attribute":  stat.Attribute("daily"),
 			"frequency": schema.StringAttribute{
 				Description: "Repeat frequency: 'daily', 'weekly', 'monthly', or 'yearly'. Defaults to 'weekly'.",
 				Optional:    true,
@@ -95,6 +123,9 @@ func (r *dailyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "Start date in YYYY-MM-DD format. Defaults to today.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"repeat": schema.SingleNestedAttribute{
 				Description: "Which days of the week the daily repeats (for weekly frequency). Defaults to Mon-Fri if not specified.",
@@ -149,6 +180,34 @@ func (r *dailyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"checklist": checklist.Attribute("daily"),
+			"reminders": schema.ListNestedAttribute{
+				Description: "Reminders Habitica sends for this daily. If omitted, reminders set in the app are left untouched; set to [] to remove all reminders.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the reminder.",
+							Computed:    true,
+						},
+						"time": schema.StringAttribute{
+							Description: "Time of day in HH:MM (24-hour, UTC) format.",
+							Required:    true,
+						},
+						"start_date": schema.StringAttribute{
+							Description: "Date from which the reminder is active, in YYYY-MM-DD format.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(),
 		},
 	}
 }
@@ -177,14 +236,25 @@ func (r *dailyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, plan.Timeouts, timeouts.Create)
+	defer cancel()
+
+	if err := alias.CheckAvailable(ctx, r.client, plan.Alias, ""); err != nil {
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Create, "Error creating daily", err)
+		return
+	}
+
 	task := r.modelToTask(ctx, &plan, &resp.Diagnostics)
+	task.Alias = alias.ToClient(plan.Alias, types.StringNull())
+	task.Checklist = checklist.ToItems(ctx, plan.Checklist, &resp.Diagnostics)
+	task.Reminders = remindersToClient(ctx, plan.Reminders, types.ListNull(reminderObjectType), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	created, err := r.client.CreateTask(ctx, task)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating daily", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Create, "Error creating daily", err)
 		return
 	}
 
@@ -201,9 +271,16 @@ func (r *dailyResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, state.Timeouts, timeouts.Read)
+	defer cancel()
+
 	task, err := r.client.GetTask(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading daily", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Read, "Error reading daily", err)
 		return
 	}
 
@@ -225,17 +302,48 @@ func (r *dailyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, plan.Timeouts, timeouts.Update)
+	defer cancel()
+
+	if err := alias.CheckAvailable(ctx, r.client, plan.Alias, state.ID.ValueString()); err != nil {
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Update, "Error updating daily", err)
+		return
+	}
+
+	// Only fields changed since the last refresh are sent, so edits made in
+	// the app to anything else are kept. Unconfigured optional attributes
+	// keep their state value in the plan and so are never sent
+	prior := r.modelToTask(ctx, &state, &resp.Diagnostics)
+	prior.Alias = alias.ToClient(state.Alias, state.Alias)
 	task := r.modelToTask(ctx, &plan, &resp.Diagnostics)
+	task.Alias = alias.ToClient(plan.Alias, state.Alias)
+	// Kept reminders reuse their IDs. Unmanaged reminders are left out of
+	// both, so they are not sent
+	task.Reminders = remindersToClient(ctx, plan.Reminders, state.Reminders, &resp.Diagnostics)
+	if task.Reminders != nil {
+		prior.Reminders = remindersToClient(ctx, state.Reminders, state.Reminders, &resp.Diagnostics)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updated, err := r.client.UpdateTask(ctx, state.ID.ValueString(), task)
+	updated, err := r.client.PatchTask(ctx, state.ID.ValueString(), &prior.UpdateTaskRequest, &task.UpdateTaskRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating daily", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Update, "Error updating daily", err)
 		return
 	}
 
+	// Checklist items are managed through their own endpoints so that item
+	// IDs and completion state survive the update
+	if !plan.Checklist.IsNull() && !plan.Checklist.IsUnknown() {
+		desired := checklist.ToItems(ctx, plan.Checklist, &resp.Diagnostics)
+		updated, err = r.client.SyncChecklist(ctx, updated, desired)
+		if err != nil {
+			timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Update, "Error updating daily checklist", err)
+			return
+		}
+	}
+
 	plan.ID = state.ID
 	r.updateModelFromTask(ctx, &plan, updated, &resp.Diagnostics)
 
@@ -249,21 +357,27 @@ func (r *dailyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, state.Timeouts, timeouts.Delete)
+	defer cancel()
+
 	err := r.client.DeleteTask(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting daily", err.Error())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Delete, "Error deleting daily", err)
 		return
 	}
 }
 
-func (r *dailyResource) modelToTask(ctx context.Context, model *dailyResourceModel, diags *diag.Diagnostics) *client.Task {
-	task := &client.Task{
-		Type:      "daily",
-		Text:      model.Text.ValueString(),
-		Notes:     model.Notes.ValueString(),
-		Priority:  model.Priority.ValueFloat64(),
-		Frequency: model.Frequency.ValueString(),
-		EveryX:    int(model.EveryX.ValueInt64()),
+func (r *dailyResource) modelToTask(ctx context.Context, model *dailyResourceModel, diags *diag.Diagnostics) *client.CreateTaskRequest {
+	task := &client.CreateTaskRequest{
+		Type: "daily",
+		UpdateTaskRequest: client.UpdateTaskRequest{
+			Text:      model.Text.ValueString(),
+			Notes:     model.Notes.ValueString(),
+			Priority:  model.Priority.ValueFloat64(),
+			Attribute: model.Attribute.ValueString(),
+			Frequency: model.Frequency.ValueString(),
+			EveryX:    int(model.EveryX.ValueInt64()),
+		},
 	}
 
 	if !model.StartDate.IsNull() && !model.StartDate.IsUnknown() {
@@ -325,8 +439,11 @@ func (r *dailyResource) modelToTask(ctx context.Context, model *dailyResourceMod
 
 func (r *dailyResource) updateModelFromTask(ctx context.Context, model *dailyResourceModel, task *client.Task, diags *diag.Diagnostics) {
 	model.Text = types.StringValue(task.Text)
+	model.Alias = alias.FromTask(task)
 	model.Notes = types.StringValue(task.Notes)
 	model.Priority = types.Float64Value(task.Priority)
+	model.Difficulty = difficulty.FromPriority(task.Priority)
+	model.Attribute = stat.FromTask(task)
 	model.Frequency = types.StringValue(task.Frequency)
 	model.EveryX = types.Int64Value(int64(task.EveryX))
 
@@ -398,10 +515,81 @@ func (r *dailyResource) updateModelFromTask(ctx context.Context, model *dailyRes
 	} else {
 		model.Tags = types.ListNull(types.StringType)
 	}
+
+	model.Checklist = checklist.FromItems(ctx, task.Checklist, diags)
+
+	reminders := make([]reminderModel, len(task.Reminders))
+	for i, rem := range task.Reminders {
+		reminders[i] = reminderModel{
+			ID:        types.StringValue(rem.ID),
+			Time:      types.StringValue(rem.Time.UTC().Format("15:04")),
+			StartDate: types.StringNull(),
+		}
+		if rem.StartDate != nil {
+			reminders[i].StartDate = types.StringValue(rem.StartDate.UTC().Format("2006-01-02"))
+		}
+	}
+	reminderList, d := types.ListValueFrom(ctx, reminderObjectType, reminders)
+	diags.Append(d...)
+	model.Reminders = reminderList
+}
+
+// remindersToClient converts planned reminders into API reminders. Reminders
+// with the same time and start date as one in prior keep its ID. A null or
+// unknown plan yields nil, leaving the reminders out of the request.
+func remindersToClient(ctx context.Context, planned, prior types.List, diags *diag.Diagnostics) []client.Reminder {
+	if planned.IsNull() || planned.IsUnknown() {
+		return nil
+	}
+
+	var models []reminderModel
+	diags.Append(planned.ElementsAs(ctx, &models, false)...)
+
+	var priorModels []reminderModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorModels, false)...)
+	}
+	used := make([]bool, len(priorModels))
+
+	reminders := make([]client.Reminder, 0, len(models))
+	for i, m := range models {
+		attrPath := path.Root("reminders").AtListIndex(i)
+
+		tod, err := time.Parse("15:04", m.Time.ValueString())
+		if err != nil {
+			diags.AddAttributeError(attrPath.AtName("time"), "Invalid reminder time", fmt.Sprintf("Expected HH:MM, got %q.", m.Time.ValueString()))
+			continue
+		}
+
+		// Only the time of day matters; anchor it on the start date when given
+		day := time.Now().UTC()
+		reminder := client.Reminder{}
+		if !m.StartDate.IsNull() && !m.StartDate.IsUnknown() {
+			start, err := time.Parse("2006-01-02", m.StartDate.ValueString())
+			if err != nil {
+				diags.AddAttributeError(attrPath.AtName("start_date"), "Invalid reminder start date", fmt.Sprintf("Expected YYYY-MM-DD, got %q.", m.StartDate.ValueString()))
+				continue
+			}
+			day = start
+			reminder.StartDate = &start
+		}
+		reminder.Time = time.Date(day.Year(), day.Month(), day.Day(), tod.Hour(), tod.Minute(), 0, 0, time.UTC)
+
+		for j, p := range priorModels {
+			if !used[j] && p.Time.Equal(m.Time) && p.StartDate.Equal(m.StartDate) {
+				reminder.ID = p.ID.ValueString()
+				used[j] = true
+				break
+			}
+		}
+
+		reminders = append(reminders, reminder)
+	}
+	return reminders
 }
 
 func (r *dailyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importid.ImportTask(ctx, r.client, "daily", req, resp)
 }
 
 // getBoolWithDefault returns the bool value if not null, otherwise returns the default
@@ -1,12 +1,17 @@
 package daily
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inannamalick/terraform-provider-habitica/internal/client"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestGetBoolWithDefault is a REGRESSION TEST for v0.2.1 bug
@@ -309,3 +314,93 @@ func TestDailyModelToTaskConversion(t *testing.T) {
 	assert.False(t, repeatConfig.Saturday) // defaulted
 	assert.False(t, repeatConfig.Sunday)   // defaulted
 }
+
+func reminderList(t *testing.T, reminders ...reminderModel) types.List {
+	t.Helper()
+	list, d := types.ListValueFrom(context.Background(), reminderObjectType, append([]reminderModel{}, reminders...))
+	require.False(t, d.HasError())
+	return list
+}
+
+// TestRemindersToClient validates reminder conversion and ID reuse on update
+func TestRemindersToClient(t *testing.T) {
+	ctx := context.Background()
+
+	prior := reminderList(t,
+		reminderModel{ID: types.StringValue("rem-1"), Time: types.StringValue("07:30"), StartDate: types.StringNull()},
+		reminderModel{ID: types.StringValue("rem-2"), Time: types.StringValue("21:00"), StartDate: types.StringValue("2025-01-01")},
+	)
+	planned := reminderList(t,
+		reminderModel{ID: types.StringUnknown(), Time: types.StringValue("21:00"), StartDate: types.StringValue("2025-01-01")},
+		reminderModel{ID: types.StringUnknown(), Time: types.StringValue("12:15"), StartDate: types.StringNull()},
+	)
+
+	var diags diag.Diagnostics
+	reminders := remindersToClient(ctx, planned, prior, &diags)
+	require.False(t, diags.HasError())
+	require.Len(t, reminders, 2)
+
+	assert.Equal(t, "rem-2", reminders[0].ID, "unchanged reminder keeps its ID")
+	assert.Equal(t, time.Date(2025, 1, 1, 21, 0, 0, 0, time.UTC), reminders[0].Time)
+	require.NotNil(t, reminders[0].StartDate)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), *reminders[0].StartDate)
+
+	assert.Empty(t, reminders[1].ID, "new reminder gets its ID from the API")
+	assert.Equal(t, "12:15", reminders[1].Time.Format("15:04"))
+	assert.Nil(t, reminders[1].StartDate)
+}
+
+// TestRemindersToClientClearAndUnmanaged validates the difference between [] and an omitted block
+func TestRemindersToClientClearAndUnmanaged(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	cleared := remindersToClient(ctx, reminderList(t), types.ListNull(reminderObjectType), &diags)
+	require.False(t, diags.HasError())
+	body, err := json.Marshal(client.UpdateTaskRequest{Text: "Stretch", Reminders: cleared})
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"reminders":[]`, "an empty list must be sent to clear reminders")
+
+	unmanaged := remindersToClient(ctx, types.ListUnknown(reminderObjectType), types.ListNull(reminderObjectType), &diags)
+	require.False(t, diags.HasError())
+	body, err = json.Marshal(client.UpdateTaskRequest{Text: "Stretch", Reminders: unmanaged})
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "reminders")
+}
+
+// TestRemindersToClientInvalidTime validates that malformed times are rejected
+func TestRemindersToClientInvalidTime(t *testing.T) {
+	planned := reminderList(t,
+		reminderModel{ID: types.StringUnknown(), Time: types.StringValue("7pm"), StartDate: types.StringNull()},
+	)
+
+	var diags diag.Diagnostics
+	remindersToClient(context.Background(), planned, types.ListNull(reminderObjectType), &diags)
+	assert.True(t, diags.HasError())
+}
+
+// TestDailyRemindersRoundTrip validates that API reminders are read back into the model
+func TestDailyRemindersRoundTrip(t *testing.T) {
+	r := &dailyResource{}
+	ctx := context.Background()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var model dailyResourceModel
+	var diags diag.Diagnostics
+	r.updateModelFromTask(ctx, &model, &client.Task{
+		ID:   "daily-uuid-1",
+		Type: "daily",
+		Text: "Morning routine",
+		Reminders: []client.Reminder{
+			{ID: "rem-1", StartDate: &start, Time: time.Date(2025, 1, 1, 7, 30, 0, 0, time.UTC)},
+		},
+	}, &diags)
+	require.False(t, diags.HasError())
+
+	var reminders []reminderModel
+	require.False(t, model.Reminders.ElementsAs(ctx, &reminders, false).HasError())
+	require.Len(t, reminders, 1)
+	assert.Equal(t, "rem-1", reminders[0].ID.ValueString())
+	assert.Equal(t, "07:30", reminders[0].Time.ValueString())
+	assert.Equal(t, "2025-01-01", reminders[0].StartDate.ValueString())
+}
@@ -0,0 +1,162 @@
+// Package difficulty holds the priority and difficulty attributes shared by
+// the habit, daily and todo resources. Habitica stores a task's difficulty as
+// a numeric priority; difficulty is the same setting by name, and either can
+// be configured.
+package difficulty
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/validate"
+)
+
+// Default is the difficulty of tasks that configure neither attribute.
+const Default = "easy"
+
+// names are the difficulty names, easiest first.
+var names = []string{"trivial", "easy", "medium", "hard"}
+
+// priorities maps difficulty names to Habitica priorities.
+var priorities = map[string]float64{
+	"trivial": 0.1,
+	"easy":    1,
+	"medium":  1.5,
+	"hard":    2,
+}
+
+// PriorityAttribute returns the priority schema attribute for the given task
+// type.
+func PriorityAttribute(taskType string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Description: fmt.Sprintf("Difficulty of the %s as a number: 0.1 (trivial), 1 (easy), 1.5 (medium), 2 (hard). "+
+			"Conflicts with difficulty. Defaults to 1.", taskType),
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Float64{
+			priorityPlanModifier{},
+		},
+		Validators: []validator.Float64{
+			validate.OneOfFloat64(0.1, 1, 1.5, 2),
+		},
+	}
+}
+
+// Attribute returns the difficulty schema attribute for the given task type.
+func Attribute(taskType string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("Difficulty of the %s by name: 'trivial', 'easy', 'medium' or 'hard'. "+
+			"Conflicts with priority. Defaults to '%s'.", taskType, Default),
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			difficultyPlanModifier{},
+		},
+		Validators: []validator.String{
+			validate.OneOf(names...),
+			conflictValidator{},
+		},
+	}
+}
+
+// FromPriority returns the difficulty name of a priority. Priorities that
+// match no difficulty, which Habitica does not normally store, yield null.
+func FromPriority(priority float64) types.String {
+	for name, p := range priorities {
+		if p == priority {
+			return types.StringValue(name)
+		}
+	}
+	return types.StringNull()
+}
+
+// priorityPlanModifier derives the priority from the configured difficulty,
+// or the default, when no priority is configured.
+type priorityPlanModifier struct{}
+
+func (m priorityPlanModifier) Description(ctx context.Context) string {
+	return "Derives the priority from difficulty when it is not configured."
+}
+
+func (m priorityPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m priorityPlanModifier) PlanModifyFloat64(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+	if req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("difficulty"), &name)...)
+	switch {
+	case name.IsUnknown():
+		resp.PlanValue = types.Float64Unknown()
+	case name.IsNull():
+		resp.PlanValue = types.Float64Value(priorities[Default])
+	default:
+		// Invalid names are reported by the validator
+		resp.PlanValue = types.Float64Value(priorities[name.ValueString()])
+	}
+}
+
+// difficultyPlanModifier derives the difficulty from the configured priority,
+// or the default, when no difficulty is configured.
+type difficultyPlanModifier struct{}
+
+func (m difficultyPlanModifier) Description(ctx context.Context) string {
+	return "Derives the difficulty from priority when it is not configured."
+}
+
+func (m difficultyPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m difficultyPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var priority types.Float64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("priority"), &priority)...)
+	switch {
+	case priority.IsUnknown():
+		resp.PlanValue = types.StringUnknown()
+	case priority.IsNull():
+		resp.PlanValue = types.StringValue(Default)
+	default:
+		resp.PlanValue = FromPriority(priority.ValueFloat64())
+	}
+}
+
+// conflictValidator rejects a difficulty configured alongside a priority.
+type conflictValidator struct{}
+
+func (v conflictValidator) Description(ctx context.Context) string {
+	return "priority must not also be set"
+}
+
+func (v conflictValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v conflictValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		return
+	}
+
+	var priority types.Float64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("priority"), &priority)...)
+	if !priority.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Conflicting Attributes",
+			"Only one of difficulty and priority can be set.",
+		)
+	}
+}
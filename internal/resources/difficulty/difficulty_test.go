@@ -0,0 +1,160 @@
+package difficulty
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"priority":   PriorityAttribute("habit"),
+		"difficulty": Attribute("habit"),
+	},
+}
+
+// config returns a configuration with the given priority and difficulty;
+// nil leaves an attribute unset.
+func config(t *testing.T, priority *float64, difficulty *string) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	objectType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{
+		"priority":   tftypes.NewValue(tftypes.Number, nil),
+		"difficulty": tftypes.NewValue(tftypes.String, nil),
+	}
+	if priority != nil {
+		values["priority"] = tftypes.NewValue(tftypes.Number, *priority)
+	}
+	if difficulty != nil {
+		values["difficulty"] = tftypes.NewValue(tftypes.String, *difficulty)
+	}
+
+	return tfsdk.Config{Schema: testSchema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestFromPriority(t *testing.T) {
+	assert.Equal(t, types.StringValue("trivial"), FromPriority(0.1))
+	assert.Equal(t, types.StringValue("easy"), FromPriority(1))
+	assert.Equal(t, types.StringValue("medium"), FromPriority(1.5))
+	assert.Equal(t, types.StringValue("hard"), FromPriority(2))
+	assert.True(t, FromPriority(1.2).IsNull())
+}
+
+func TestPriorityValidator(t *testing.T) {
+	for value, valid := range map[float64]bool{
+		0.1: true,
+		1:   true,
+		1.5: true,
+		2:   true,
+		1.2: false,
+		0:   false,
+		3:   false,
+	} {
+		req := validator.Float64Request{Path: path.Root("priority"), ConfigValue: types.Float64Value(value)}
+		resp := &validator.Float64Response{}
+		for _, v := range testSchema.Attributes["priority"].(schema.Float64Attribute).Validators {
+			v.ValidateFloat64(context.Background(), req, resp)
+		}
+		assert.Equal(t, !valid, resp.Diagnostics.HasError(), "%g", value)
+	}
+}
+
+func TestDifficultyValidator(t *testing.T) {
+	tests := []struct {
+		name       string
+		priority   *float64
+		difficulty string
+		wantErr    string
+	}{
+		{"valid", nil, "medium", ""},
+		{"unknown name", nil, "impossible", "Invalid Attribute Value"},
+		{"conflicts with priority", ptr(1.5), "medium", "Conflicting Attributes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("difficulty"),
+				ConfigValue: types.StringValue(tt.difficulty),
+				Config:      config(t, tt.priority, &tt.difficulty),
+			}
+			resp := &validator.StringResponse{}
+			for _, v := range testSchema.Attributes["difficulty"].(schema.StringAttribute).Validators {
+				v.ValidateString(context.Background(), req, resp)
+			}
+
+			if tt.wantErr == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+				return
+			}
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.wantErr, resp.Diagnostics[0].Summary())
+		})
+	}
+}
+
+func TestPlanModifiers(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		priority       *float64
+		difficulty     *string
+		wantPriority   float64
+		wantDifficulty string
+	}{
+		{"neither", nil, nil, 1, "easy"},
+		{"difficulty", nil, ptr("hard"), 2, "hard"},
+		{"priority", ptr(0.1), nil, 0.1, "trivial"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config(t, tt.priority, tt.difficulty)
+			plan := tfsdk.Plan{Schema: cfg.Schema, Raw: cfg.Raw}
+
+			var configPriority types.Float64
+			cfg.GetAttribute(ctx, path.Root("priority"), &configPriority)
+			priorityResp := &planmodifier.Float64Response{PlanValue: types.Float64Unknown()}
+			priorityPlanModifier{}.PlanModifyFloat64(ctx, planmodifier.Float64Request{
+				Config:      cfg,
+				Plan:        plan,
+				ConfigValue: configPriority,
+				PlanValue:   types.Float64Unknown(),
+			}, priorityResp)
+			if tt.priority == nil {
+				assert.Equal(t, types.Float64Value(tt.wantPriority), priorityResp.PlanValue)
+			} else {
+				assert.True(t, priorityResp.PlanValue.IsUnknown(), "configured priority is left alone")
+			}
+
+			var configDifficulty types.String
+			cfg.GetAttribute(ctx, path.Root("difficulty"), &configDifficulty)
+			difficultyResp := &planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+			difficultyPlanModifier{}.PlanModifyString(ctx, planmodifier.StringRequest{
+				Config:      cfg,
+				Plan:        plan,
+				ConfigValue: configDifficulty,
+				PlanValue:   types.StringUnknown(),
+			}, difficultyResp)
+			if tt.difficulty == nil {
+				assert.Equal(t, types.StringValue(tt.wantDifficulty), difficultyResp.PlanValue)
+			} else {
+				assert.True(t, difficultyResp.PlanValue.IsUnknown(), "configured difficulty is left alone")
+			}
+		})
+	}
+}
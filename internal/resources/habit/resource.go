@@ -2,17 +2,25 @@ package habit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/alias"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/difficulty"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/importid"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/stat"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/timeouts"
+	"github.com/inannamalick/terraform-provider-habitica/internal/validate"
 )
 
 var (
@@ -31,15 +39,28 @@ type habitResource struct {
 }
 
 type habitResourceModel struct {
-	ID       types.String  `tfsdk:"id"`
-	Text     types.String  `tfsdk:"text"`
-	Notes    types.String  `tfsdk:"notes"`
-	Priority types.Float64 `tfsdk:"priority"`
-	Up       types.Bool    `tfsdk:"up"`
-	Down     types.Bool    `tfsdk:"down"`
-	Tags     types.List    `tfsdk:"tags"`
+	ID         types.String  `tfsdk:"id"`
+	Text       types.String  `tfsdk:"text"`
+	Alias      types.String  `tfsdk:"alias"`
+	Notes      types.String  `tfsdk:"notes"`
+	Priority   types.Float64 `tfsdk:"priority"`
+	Difficulty types.String  `tfsdk:"difficulty"`
+	Attribute  types.String  `tfsdk:"attribute"`
+	Up         types.Bool    `tfsdk:"up"`
+	Down       types.Bool    `tfsdk:"down"`
+	Frequency  types.String  `tfsdk:"frequency"`
+	Tags       types.List    `tfsdk:"tags"`
+	Timeouts   types.Object  `tfsdk:"timeouts"`
+
+	// Read-only, changed by scoring the habit
+	CounterUp   types.Int64   `tfsdk:"counter_up"`
+	CounterDown types.Int64   `tfsdk:"counter_down"`
+	Value       types.Float64 `tfsdk:"value"`
 }
 
+// frequencies are the periods after which a habit's counters reset.
+var frequencies = []string{"daily", "weekly", "monthly"}
+
 func (r *habitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_habit"
 }
@@ -59,32 +80,63 @@ func (r *habitResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "The title of the habit.",
 				Required:    true,
 			},
+			"alias": alias.Attribute("habit"),
 			"notes": schema.StringAttribute{
 				Description: "Extra notes or description for the habit.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"priority": schema.Float64Attribute{
-				Description: "Difficulty level: 0.1 (trivial), 1 (easy), 1.5 (medium), 2 (hard). Defaults to 1.",
-				Optional:    true,
-				Computed:    true,
-				Default:     float64default.StaticFloat64(1),
-			},
+			"priority":   difficulty.PriorityAttribute("habit"),
+			"difficulty": difficulty.Attribute("habit"),
+			"attribute":  stat.Attribute("habit"),
 			"up": schema.BoolAttribute{
 				Description: "Whether the habit can be scored positively (+). Defaults to true if not specified.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"down": schema.BoolAttribute{
 				Description: "Whether the habit can be scored negatively (-). Defaults to false if not specified.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"frequency": schema.StringAttribute{
+				Description: "How often the habit's counters reset: 'daily', 'weekly', or 'monthly'. Defaults to 'daily'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("daily"),
+				Validators: []validator.String{
+					validate.OneOf(frequencies...),
+				},
 			},
 			"tags": schema.ListAttribute{
 				Description: "List of tag IDs to associate with this habit.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"counter_up": schema.Int64Attribute{
+				Description: "How often the habit was scored positively in the current period.",
+				Computed:    true,
+			},
+			"counter_down": schema.Int64Attribute{
+				Description: "How often the habit was scored negatively in the current period.",
+				Computed:    true,
+			},
+			"value": schema.Float64Attribute{
+				Description: "The habit's value, which rises as it is scored positively and falls as it is scored negatively. Determines its color in the app.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(),
 		},
 	}
 }
@@ -113,31 +165,22 @@ func (r *habitResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Handle defaults for up/down
-	up := getBoolWithDefault(plan.Up, true)
-	down := getBoolWithDefault(plan.Down, false)
-
-	task := &client.Task{
-		Type:     "habit",
-		Text:     plan.Text.ValueString(),
-		Notes:    plan.Notes.ValueString(),
-		Priority: plan.Priority.ValueFloat64(),
-		Up:       &up,
-		Down:     &down,
+	ctx, cancel := timeouts.WithTimeout(ctx, plan.Timeouts, timeouts.Create)
+	defer cancel()
+
+	if err := alias.CheckAvailable(ctx, r.client, plan.Alias, ""); err != nil {
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Create, "Error creating habit", err)
+		return
 	}
 
-	if !plan.Tags.IsNull() {
-		var tags []string
-		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		task.Tags = tags
+	task := r.modelToTask(ctx, &plan, types.StringNull(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	created, err := r.client.CreateTask(ctx, task)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating habit", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Create, "Error creating habit", err)
 		return
 	}
 
@@ -154,9 +197,16 @@ func (r *habitResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, state.Timeouts, timeouts.Read)
+	defer cancel()
+
 	task, err := r.client.GetTask(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading habit", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Read, "Error reading habit", err)
 		return
 	}
 
@@ -178,32 +228,26 @@ func (r *habitResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Handle defaults for up/down
-	up := getBoolWithDefault(plan.Up, true)
-	down := getBoolWithDefault(plan.Down, false)
-
-	task := &client.Task{
-		Text:     plan.Text.ValueString(),
-		Notes:    plan.Notes.ValueString(),
-		Priority: plan.Priority.ValueFloat64(),
-		Up:       &up,
-		Down:     &down,
+	ctx, cancel := timeouts.WithTimeout(ctx, plan.Timeouts, timeouts.Update)
+	defer cancel()
+
+	if err := alias.CheckAvailable(ctx, r.client, plan.Alias, state.ID.ValueString()); err != nil {
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Update, "Error updating habit", err)
+		return
 	}
 
-	if !plan.Tags.IsNull() {
-		var tags []string
-		resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		task.Tags = tags
-	} else {
-		task.Tags = []string{}
+	// Only fields changed since the last refresh are sent, so edits made in
+	// the app to anything else are kept. Unconfigured optional attributes
+	// keep their state value in the plan and so are never sent
+	prior := r.modelToTask(ctx, &state, state.Alias, &resp.Diagnostics)
+	task := r.modelToTask(ctx, &plan, state.Alias, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	updated, err := r.client.UpdateTask(ctx, state.ID.ValueString(), task)
+	updated, err := r.client.PatchTask(ctx, state.ID.ValueString(), &prior.UpdateTaskRequest, &task.UpdateTaskRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating habit", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Update, "Error updating habit", err)
 		return
 	}
 
@@ -220,17 +264,53 @@ func (r *habitResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, state.Timeouts, timeouts.Delete)
+	defer cancel()
+
 	err := r.client.DeleteTask(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting habit", err.Error())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Delete, "Error deleting habit", err)
 		return
 	}
 }
 
+// modelToTask converts the model into an API request. priorAlias is the
+// alias in state, so that removing it from the configuration clears it.
+func (r *habitResource) modelToTask(ctx context.Context, model *habitResourceModel, priorAlias types.String, diags *diag.Diagnostics) *client.CreateTaskRequest {
+	// Handle defaults for up/down
+	up := getBoolWithDefault(model.Up, true)
+	down := getBoolWithDefault(model.Down, false)
+
+	task := &client.CreateTaskRequest{
+		Type: "habit",
+		UpdateTaskRequest: client.UpdateTaskRequest{
+			Text:      model.Text.ValueString(),
+			Alias:     alias.ToClient(model.Alias, priorAlias),
+			Notes:     model.Notes.ValueString(),
+			Priority:  model.Priority.ValueFloat64(),
+			Attribute: model.Attribute.ValueString(),
+			Up:        &up,
+			Down:      &down,
+			Frequency: model.Frequency.ValueString(),
+		},
+	}
+
+	if !model.Tags.IsNull() {
+		var tags []string
+		diags.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+		task.Tags = tags
+	}
+
+	return task
+}
+
 func (r *habitResource) updateModelFromTask(ctx context.Context, model *habitResourceModel, task *client.Task, diags *diag.Diagnostics) {
 	model.Text = types.StringValue(task.Text)
+	model.Alias = alias.FromTask(task)
 	model.Notes = types.StringValue(task.Notes)
 	model.Priority = types.Float64Value(task.Priority)
+	model.Difficulty = difficulty.FromPriority(task.Priority)
+	model.Attribute = stat.FromTask(task)
 
 	if task.Up != nil {
 		model.Up = types.BoolValue(*task.Up)
@@ -239,6 +319,20 @@ func (r *habitResource) updateModelFromTask(ctx context.Context, model *habitRes
 		model.Down = types.BoolValue(*task.Down)
 	}
 
+	if task.Frequency != "" {
+		model.Frequency = types.StringValue(task.Frequency)
+	} else {
+		model.Frequency = types.StringValue("daily")
+	}
+
+	model.CounterUp = types.Int64Value(int64(task.CounterUp))
+	model.CounterDown = types.Int64Value(int64(task.CounterDown))
+	if task.Value != nil {
+		model.Value = types.Float64Value(*task.Value)
+	} else {
+		model.Value = types.Float64Value(0)
+	}
+
 	if len(task.Tags) > 0 {
 		tagList, d := types.ListValueFrom(ctx, types.StringType, task.Tags)
 		diags.Append(d...)
@@ -249,7 +343,7 @@ func (r *habitResource) updateModelFromTask(ctx context.Context, model *habitRes
 }
 
 func (r *habitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importid.ImportTask(ctx, r.client, "habit", req, resp)
 }
 
 // getBoolWithDefault returns the bool value if not null, otherwise returns the default
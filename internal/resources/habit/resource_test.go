@@ -1,10 +1,25 @@
 package habit
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/timeouts"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestGetBoolWithDefault is a REGRESSION TEST for v0.2.2 bug
@@ -140,3 +155,136 @@ func TestHabitUpdateModelFromTask(t *testing.T) {
 	// Skipping detailed implementation for now as it requires full resource context
 	t.Skip("Full resource tests require provider context")
 }
+
+// TestHabitAttributeDrift validates that a stat changed in the app is read
+// back into state, so the plan reverts it
+func TestHabitAttributeDrift(t *testing.T) {
+	r := &habitResource{}
+	model := &habitResourceModel{Attribute: types.StringValue("str")}
+
+	var diags diag.Diagnostics
+	r.updateModelFromTask(context.Background(), model, &client.Task{Text: "Read", Attribute: "int"}, &diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "int", model.Attribute.ValueString())
+}
+
+func TestHabitUpdateModelFromTaskCounters(t *testing.T) {
+	r := &habitResource{}
+	model := &habitResourceModel{}
+
+	var diags diag.Diagnostics
+	r.updateModelFromTask(context.Background(), model, &client.Task{
+		Text:        "Drink water",
+		Frequency:   "weekly",
+		CounterUp:   3,
+		CounterDown: 1,
+		Value:       testutil.Float64Ptr(2.5),
+	}, &diags)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "weekly", model.Frequency.ValueString())
+	assert.Equal(t, int64(3), model.CounterUp.ValueInt64())
+	assert.Equal(t, int64(1), model.CounterDown.ValueInt64())
+	assert.Equal(t, 2.5, model.Value.ValueFloat64())
+
+	// Habits created before frequencies existed have none
+	r.updateModelFromTask(context.Background(), model, &client.Task{Text: "Drink water"}, &diags)
+	assert.Equal(t, "daily", model.Frequency.ValueString())
+	assert.Equal(t, int64(0), model.CounterUp.ValueInt64())
+	assert.Equal(t, 0.0, model.Value.ValueFloat64())
+}
+
+func TestFrequencyValidator(t *testing.T) {
+	schemaResp := &resource.SchemaResponse{}
+	NewResource().Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	frequency := schemaResp.Schema.Attributes["frequency"].(schema.StringAttribute)
+
+	for value, valid := range map[string]bool{
+		"daily":   true,
+		"weekly":  true,
+		"monthly": true,
+		"yearly":  false,
+		"Daily":   false,
+	} {
+		req := validator.StringRequest{Path: path.Root("frequency"), ConfigValue: types.StringValue(value)}
+		resp := &validator.StringResponse{}
+		for _, v := range frequency.Validators {
+			v.ValidateString(context.Background(), req, resp)
+		}
+		assert.Equal(t, !valid, resp.Diagnostics.HasError(), "%q", value)
+	}
+}
+
+// TestHabitUpdateKeepsUnconfiguredFields validates that changing the text of
+// a habit whose notes, up and down are not configured sends only the text,
+// leaving the values last set in the app alone
+func TestHabitUpdateKeepsUnconfiguredFields(t *testing.T) {
+	ctx := context.Background()
+
+	inApp := testutil.TestHabit1
+	inApp.Notes = "Written in the app"
+	inApp.Up = testutil.BoolPtr(false)
+	inApp.Down = testutil.BoolPtr(true)
+
+	var putBody map[string]any
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tasks/habit-uuid-1": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPut, r.Method)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&putBody))
+			updated := inApp
+			updated.Text = "Run"
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(testutil.MockTaskResponse(&updated))
+		},
+	})
+	defer server.Close()
+
+	r := &habitResource{client: testutil.NewTestClient(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	attrs := schemaResp.Schema.Attributes
+
+	state := habitResourceModel{
+		ID:       types.StringValue(inApp.ID),
+		Tags:     types.ListNull(types.StringType),
+		Timeouts: types.ObjectNull(timeouts.AttrTypes),
+	}
+	var diags diag.Diagnostics
+	r.updateModelFromTask(ctx, &state, &inApp, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	tfState := tfsdk.State{Schema: schemaResp.Schema}
+	tfState.Raw = tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	require.False(t, tfState.Set(ctx, &state).HasError())
+
+	// Terraform plans unconfigured optional and computed attributes as
+	// unknown, then runs the schema's plan modifiers
+	plan := state
+	plan.Text = types.StringValue("Run")
+	plan.CounterUp = types.Int64Unknown()
+	plan.CounterDown = types.Int64Unknown()
+	plan.Value = types.Float64Unknown()
+
+	notes := &planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+	for _, m := range attrs["notes"].(schema.StringAttribute).PlanModifiers {
+		m.PlanModifyString(ctx, planmodifier.StringRequest{State: tfState, StateValue: state.Notes, PlanValue: notes.PlanValue, ConfigValue: types.StringNull()}, notes)
+	}
+	plan.Notes = notes.PlanValue
+	for name, value := range map[string]*types.Bool{"up": &plan.Up, "down": &plan.Down} {
+		stateValue := *value
+		resp := &planmodifier.BoolResponse{PlanValue: types.BoolUnknown()}
+		for _, m := range attrs[name].(schema.BoolAttribute).PlanModifiers {
+			m.PlanModifyBool(ctx, planmodifier.BoolRequest{State: tfState, StateValue: stateValue, PlanValue: resp.PlanValue, ConfigValue: types.BoolNull()}, resp)
+		}
+		*value = resp.PlanValue
+	}
+
+	tfPlan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tfState.Raw}
+	require.False(t, tfPlan.Set(ctx, &plan).HasError())
+
+	resp := &resource.UpdateResponse{State: tfState}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfPlan, State: tfState}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.Equal(t, map[string]any{"text": "Run"}, putBody)
+}
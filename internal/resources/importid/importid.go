@@ -0,0 +1,162 @@
+// Package importid resolves the IDs given to terraform import. Besides a
+// UUID, tasks can be imported by alias or by "text:<text>", tags by
+// "name:<name>", and webhooks by "label:<label>" or their URL, so users don't
+// have to look up UUIDs first.
+package importid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// lookupPrefixes mark an import ID as a lookup by task text or tag name.
+// Both are accepted for either kind of resource.
+var lookupPrefixes = []string{"name:", "text:"}
+
+// ImportTask imports a task of the given type, resolving the import ID with
+// Task.
+func ImportTask(ctx context.Context, c *client.Client, taskType string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := Task(ctx, c, taskType, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing "+taskType, err.Error())
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), resource.ImportStateRequest{ID: id}, resp)
+}
+
+// ImportTag imports a tag, resolving the import ID with Tag.
+func ImportTag(ctx context.Context, c *client.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := Tag(ctx, c, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing tag", err.Error())
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), resource.ImportStateRequest{ID: id}, resp)
+}
+
+// ImportWebhook imports a webhook, resolving the import ID with Webhook.
+func ImportWebhook(ctx context.Context, c *client.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := Webhook(ctx, c, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing webhook", err.Error())
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), resource.ImportStateRequest{ID: id}, resp)
+}
+
+// Task returns the ID of the task of the given type that importID refers to:
+// a UUID must be a task of that type, "text:<text>" or "name:<text>" matches
+// the task text exactly, and anything else is matched against task aliases.
+func Task(ctx context.Context, c *client.Client, taskType, importID string) (string, error) {
+	if uuidPattern.MatchString(importID) {
+		task, err := c.GetTask(ctx, importID)
+		if errors.Is(err, client.ErrNotFound) {
+			return "", fmt.Errorf("no %s with ID %q found", taskType, importID)
+		}
+		if err != nil {
+			return "", err
+		}
+		if task.Type != taskType {
+			return "", fmt.Errorf("task %q is a %s, not a %s", importID, task.Type, taskType)
+		}
+		return task.ID, nil
+	}
+
+	tasks, err := c.ListTasks(ctx, client.ListTasksOptions{Type: taskType, IncludeCompletedTodos: true})
+	if err != nil {
+		return "", err
+	}
+
+	text, byText := trimLookupPrefix(importID)
+	var matches []string
+	for _, task := range tasks {
+		if (byText && task.Text == text) || (!byText && task.Alias != nil && *task.Alias == importID) {
+			matches = append(matches, task.ID)
+		}
+	}
+
+	if byText {
+		return single(matches, fmt.Sprintf("%s with text %q", taskType, text))
+	}
+	return single(matches, fmt.Sprintf("%s with alias %q", taskType, importID))
+}
+
+// Tag returns the ID of the tag that importID refers to: "name:<name>"
+// matches the tag name exactly, and anything else is used as the tag ID.
+func Tag(ctx context.Context, c *client.Client, importID string) (string, error) {
+	name, byName := trimLookupPrefix(importID)
+	if !byName {
+		return importID, nil
+	}
+
+	tags, err := c.ListTags(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for _, tag := range tags {
+		if tag.Name == name {
+			matches = append(matches, tag.ID)
+		}
+	}
+	return single(matches, fmt.Sprintf("tag named %q", name))
+}
+
+// Webhook returns the ID of the webhook that importID refers to:
+// "label:<label>" matches the webhook label exactly, an http or https URL
+// matches the webhook URL, and anything else is used as the webhook ID.
+func Webhook(ctx context.Context, c *client.Client, importID string) (string, error) {
+	label, byLabel := strings.CutPrefix(importID, "label:")
+	byURL := strings.HasPrefix(importID, "http://") || strings.HasPrefix(importID, "https://")
+	if !byLabel && !byURL {
+		return importID, nil
+	}
+
+	webhooks, err := c.GetWebhooks(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for _, webhook := range webhooks {
+		if (byLabel && webhook.Label == label) || (byURL && webhook.URL == importID) {
+			matches = append(matches, webhook.ID)
+		}
+	}
+
+	if byLabel {
+		return single(matches, fmt.Sprintf("webhook with label %q", label))
+	}
+	return single(matches, fmt.Sprintf("webhook with URL %q", importID))
+}
+
+func trimLookupPrefix(importID string) (string, bool) {
+	for _, prefix := range lookupPrefixes {
+		if value, ok := strings.CutPrefix(importID, prefix); ok {
+			return value, true
+		}
+	}
+	return importID, false
+}
+
+// single returns the only match, or an error naming what was looked up.
+func single(matches []string, what string) (string, error) {
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s found", what)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("more than one %s found, import by ID instead: %s", what, strings.Join(matches, ", "))
+	}
+}
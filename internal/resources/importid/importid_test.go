@@ -0,0 +1,166 @@
+package importid
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	uuid        = "0b8a1c3e-5d2f-4e6a-9b7c-1d2e3f4a5b6c"
+	todoUUID    = "7f3e2d1c-0b9a-4876-9543-210fedcba987"
+	missingUUID = "00000000-0000-4000-8000-000000000000"
+)
+
+func newClient(t *testing.T) *client.Client {
+	t.Helper()
+
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tasks/user": func(w http.ResponseWriter, r *http.Request) {
+			var tasks []client.Task
+			switch r.URL.Query().Get("type") {
+			case "habits":
+				tasks = []client.Task{
+					{ID: "habit-1", Type: "habit", Text: "Drink water", Alias: testutil.StringPtr("drink-water")},
+					{ID: "habit-2", Type: "habit", Text: "Stretch"},
+					{ID: "habit-3", Type: "habit", Text: "Stretch"},
+				}
+			case "todos":
+				tasks = []client.Task{{ID: "todo-1", Type: "todo", Text: "Taxes"}}
+			case "completedTodos":
+				tasks = []client.Task{{ID: "todo-2", Type: "todo", Text: "Groceries", Completed: true}}
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write(testutil.MockTasksResponse(tasks))
+		},
+		"/tasks/": func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/tasks/" + uuid:
+				w.WriteHeader(http.StatusOK)
+				w.Write(testutil.MockTaskResponse(&client.Task{ID: uuid, Type: "habit", Text: "Meditate"}))
+			case "/tasks/" + todoUUID:
+				w.WriteHeader(http.StatusOK)
+				w.Write(testutil.MockTaskResponse(&client.Task{ID: todoUUID, Type: "todo", Text: "Renew passport"}))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				w.Write(testutil.MockErrorResponse(http.StatusNotFound, "Task not found."))
+			}
+		},
+		"/user/webhook": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write(testutil.MockWebhooksResponse([]client.Webhook{
+				{ID: "webhook-1", URL: "https://example.com/hook", Label: "ci"},
+				{ID: "webhook-2", URL: "https://example.com/other", Label: "chat"},
+				{ID: "webhook-3", URL: "https://example.com/other", Label: "chat"},
+			}))
+		},
+		"/tags": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write(testutil.MockTagsResponse([]client.Tag{
+				{ID: "tag-1", Name: "work"},
+				{ID: "tag-2", Name: "home"},
+				{ID: "tag-3", Name: "home"},
+			}))
+		},
+	})
+	t.Cleanup(server.Close)
+
+	return testutil.NewTestClient(server.URL)
+}
+
+func TestTask(t *testing.T) {
+	c := newClient(t)
+
+	tests := []struct {
+		name     string
+		taskType string
+		importID string
+		want     string
+		wantErr  string
+	}{
+		{"uuid", "habit", uuid, uuid, ""},
+		{"uuid of another type", "habit", todoUUID, "", `task "` + todoUUID + `" is a todo, not a habit`},
+		{"unknown uuid", "habit", missingUUID, "", `no habit with ID "` + missingUUID + `" found`},
+		{"alias", "habit", "drink-water", "habit-1", ""},
+		{"text", "habit", "text:Drink water", "habit-1", ""},
+		{"name prefix", "habit", "name:Drink water", "habit-1", ""},
+		{"completed todo", "todo", "text:Groceries", "todo-2", ""},
+		{"unknown alias", "habit", "walk", "", `no habit with alias "walk" found`},
+		{"unknown text", "todo", "text:Drink water", "", `no todo with text "Drink water" found`},
+		{"ambiguous", "habit", "text:Stretch", "", `more than one habit with text "Stretch" found, import by ID instead: habit-2, habit-3`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := Task(context.Background(), c, tt.taskType, tt.importID)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+}
+
+func TestTag(t *testing.T) {
+	c := newClient(t)
+
+	tests := []struct {
+		name     string
+		importID string
+		want     string
+		wantErr  string
+	}{
+		{"id", "tag-2", "tag-2", ""},
+		{"name", "name:work", "tag-1", ""},
+		{"unknown name", "name:gym", "", `no tag named "gym" found`},
+		{"ambiguous", "name:home", "", `more than one tag named "home" found, import by ID instead: tag-2, tag-3`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := Tag(context.Background(), c, tt.importID)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	c := newClient(t)
+
+	tests := []struct {
+		name     string
+		importID string
+		want     string
+		wantErr  string
+	}{
+		{"id", "webhook-2", "webhook-2", ""},
+		{"label", "label:ci", "webhook-1", ""},
+		{"url", "https://example.com/hook", "webhook-1", ""},
+		{"unknown label", "label:deploy", "", `no webhook with label "deploy" found`},
+		{"ambiguous url", "https://example.com/other", "", `more than one webhook with URL "https://example.com/other" found, import by ID instead: webhook-2, webhook-3`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := Webhook(context.Background(), c, tt.importID)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+}
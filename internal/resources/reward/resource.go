@@ -0,0 +1,261 @@
+package reward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/importid"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/stat"
+)
+
+var (
+	_ resource.Resource                   = &rewardResource{}
+	_ resource.ResourceWithConfigure      = &rewardResource{}
+	_ resource.ResourceWithImportState    = &rewardResource{}
+	_ resource.ResourceWithValidateConfig = &rewardResource{}
+)
+
+// NewResource returns a new reward resource.
+func NewResource() resource.Resource {
+	return &rewardResource{}
+}
+
+type rewardResource struct {
+	client *client.Client
+}
+
+type rewardResourceModel struct {
+	ID        types.String  `tfsdk:"id"`
+	Text      types.String  `tfsdk:"text"`
+	Notes     types.String  `tfsdk:"notes"`
+	Value     types.Float64 `tfsdk:"value"`
+	Attribute types.String  `tfsdk:"attribute"`
+	Tags      types.List    `tfsdk:"tags"`
+}
+
+func (r *rewardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reward"
+}
+
+func (r *rewardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Habitica custom reward that can be bought with gold.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the reward.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"text": schema.StringAttribute{
+				Description: "The title of the reward.",
+				Required:    true,
+			},
+			"notes": schema.StringAttribute{
+				Description: "Extra notes or description for the reward.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value": schema.Float64Attribute{
+				Description: "Gold cost of the reward. Must not be negative. Defaults to 10.",
+				Optional:    true,
+				Computed:    true,
+				Default:     float64default.StaticFloat64(10),
+			},
+			"attribute": stat.Attribute("reward"),
+			"tags": schema.ListAttribute{
+				Description: "List of tag IDs to associate with this reward.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *rewardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var value types.Float64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value"), &value)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !value.IsNull() && !value.IsUnknown() && value.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid reward cost",
+			fmt.Sprintf("The gold cost of a reward must not be negative, got: %g", value.ValueFloat64()),
+		)
+	}
+}
+
+func (r *rewardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *rewardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rewardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task := r.modelToTask(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateTask(ctx, task)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating reward", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	r.updateModelFromTask(ctx, &plan, created, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *rewardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rewardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.client.GetTask(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading reward", err.Error())
+		return
+	}
+
+	r.updateModelFromTask(ctx, &state, task, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *rewardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rewardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state rewardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only fields changed since the last refresh are sent, so edits made in
+	// the app to anything else are kept. Unconfigured optional attributes
+	// keep their state value in the plan and so are never sent
+	prior := r.modelToTask(ctx, &state, &resp.Diagnostics)
+	task := r.modelToTask(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.PatchTask(ctx, state.ID.ValueString(), &prior.UpdateTaskRequest, &task.UpdateTaskRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating reward", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	r.updateModelFromTask(ctx, &plan, updated, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *rewardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state rewardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTask(ctx, state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting reward", err.Error())
+		return
+	}
+}
+
+func (r *rewardResource) modelToTask(ctx context.Context, model *rewardResourceModel, diags *diag.Diagnostics) *client.CreateTaskRequest {
+	// Always send the cost, even when zero, so a reward can be made free
+	value := model.Value.ValueFloat64()
+
+	task := &client.CreateTaskRequest{
+		Type: "reward",
+		UpdateTaskRequest: client.UpdateTaskRequest{
+			Text:      model.Text.ValueString(),
+			Notes:     model.Notes.ValueString(),
+			Attribute: model.Attribute.ValueString(),
+			Value:     &value,
+		},
+	}
+
+	if !model.Tags.IsNull() {
+		var tags []string
+		diags.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+		task.Tags = tags
+	}
+
+	return task
+}
+
+func (r *rewardResource) updateModelFromTask(ctx context.Context, model *rewardResourceModel, task *client.Task, diags *diag.Diagnostics) {
+	model.Text = types.StringValue(task.Text)
+	model.Notes = types.StringValue(task.Notes)
+	model.Attribute = stat.FromTask(task)
+
+	if task.Value != nil {
+		model.Value = types.Float64Value(*task.Value)
+	} else {
+		model.Value = types.Float64Value(0)
+	}
+
+	if len(task.Tags) > 0 {
+		tagList, d := types.ListValueFrom(ctx, types.StringType, task.Tags)
+		diags.Append(d...)
+		model.Tags = tagList
+	} else {
+		model.Tags = types.ListNull(types.StringType)
+	}
+}
+
+func (r *rewardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.ImportTask(ctx, r.client, "reward", req, resp)
+}
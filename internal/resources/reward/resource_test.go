@@ -0,0 +1,92 @@
+package reward
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRewardClientCreate validates that the gold cost is sent as "value"
+func TestRewardClientCreate(t *testing.T) {
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tasks/user": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+
+			assert.Equal(t, "reward", body["type"])
+			assert.Equal(t, "Coffee break", body["text"])
+			assert.Equal(t, 25.0, body["value"])
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(testutil.MockTaskResponse(&testutil.TestReward1))
+		},
+	})
+	defer server.Close()
+
+	c := testutil.NewTestClient(server.URL)
+	created, err := c.CreateTask(context.Background(), &client.CreateTaskRequest{
+		Type: "reward",
+		UpdateTaskRequest: client.UpdateTaskRequest{
+			Text:  "Coffee break",
+			Value: testutil.Float64Ptr(25),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "reward-uuid-1", created.ID)
+	require.NotNil(t, created.Value)
+	assert.Equal(t, 25.0, *created.Value)
+}
+
+// TestRewardFreeCostIsSent validates that a zero cost is not dropped by omitempty
+func TestRewardFreeCostIsSent(t *testing.T) {
+	r := &rewardResource{}
+	model := &rewardResourceModel{
+		Text:  types.StringValue("Free reward"),
+		Notes: types.StringValue(""),
+		Value: types.Float64Value(0),
+		Tags:  types.ListNull(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	task := r.modelToTask(context.Background(), model, &diags)
+	require.False(t, diags.HasError())
+
+	body, err := json.Marshal(task)
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	assert.Contains(t, decoded, "value")
+	assert.Equal(t, 0.0, decoded["value"])
+}
+
+// TestRewardUpdateModelFromTask validates that API state round-trips into the model
+func TestRewardUpdateModelFromTask(t *testing.T) {
+	r := &rewardResource{}
+	ctx := context.Background()
+
+	var model rewardResourceModel
+	var diags diag.Diagnostics
+	r.updateModelFromTask(ctx, &model, &testutil.TestReward1, &diags)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "Coffee break", model.Text.ValueString())
+	assert.Equal(t, "Treat yourself", model.Notes.ValueString())
+	assert.Equal(t, 25.0, model.Value.ValueFloat64())
+
+	var tags []string
+	require.False(t, model.Tags.ElementsAs(ctx, &tags, false).HasError())
+	assert.Equal(t, []string{"tag-uuid-1"}, tags)
+}
@@ -0,0 +1,43 @@
+// Package stat holds the attribute schema shared by all task resources: the
+// character stat (STR, INT, CON or PER) that scoring a task trains.
+package stat
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/validate"
+)
+
+// Default is the stat Habitica assigns to tasks created without one.
+const Default = "str"
+
+// Values are the stats a task can train.
+var Values = []string{"str", "int", "con", "per"}
+
+// Attribute returns the attribute schema attribute for the given task type.
+func Attribute(taskType string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The character stat the %s trains: 'str' (strength), 'int' (intelligence), 'con' (constitution) or 'per' (perception). "+
+			"Defaults to '%s'.", taskType, Default),
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(Default),
+		Validators: []validator.String{
+			validate.OneOf(Values...),
+		},
+	}
+}
+
+// FromTask returns the task's stat as a model value, so that a stat changed
+// in the app shows up as drift.
+func FromTask(task *client.Task) types.String {
+	if task.Attribute == "" {
+		return types.StringValue(Default)
+	}
+	return types.StringValue(task.Attribute)
+}
@@ -0,0 +1,34 @@
+package stat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatValidator(t *testing.T) {
+	for value, valid := range map[string]bool{
+		"str":      true,
+		"int":      true,
+		"con":      true,
+		"per":      true,
+		"STR":      false,
+		"strength": false,
+		"":         false,
+	} {
+		req := validator.StringRequest{Path: path.Root("attribute"), ConfigValue: types.StringValue(value)}
+		resp := &validator.StringResponse{}
+		Attribute("habit").Validators[0].ValidateString(context.Background(), req, resp)
+		assert.Equal(t, !valid, resp.Diagnostics.HasError(), "%q", value)
+	}
+}
+
+func TestFromTask(t *testing.T) {
+	assert.Equal(t, types.StringValue("per"), FromTask(&client.Task{Attribute: "per"}))
+	assert.Equal(t, types.StringValue(Default), FromTask(&client.Task{}), "tasks without a stat train the default")
+}
@@ -2,15 +2,17 @@ package tag
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/importid"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/timeouts"
 )
 
 var (
@@ -29,8 +31,9 @@ type tagResource struct {
 }
 
 type tagResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 func (r *tagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +56,9 @@ func (r *tagResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(),
+		},
 	}
 }
 
@@ -80,9 +86,12 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, plan.Timeouts, timeouts.Create)
+	defer cancel()
+
 	tag, err := r.client.CreateTag(ctx, plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating tag", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Create, "Error creating tag", err)
 		return
 	}
 
@@ -99,9 +108,16 @@ func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, state.Timeouts, timeouts.Read)
+	defer cancel()
+
 	tag, err := r.client.GetTag(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading tag", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Read, "Error reading tag", err)
 		return
 	}
 
@@ -123,9 +139,12 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, plan.Timeouts, timeouts.Update)
+	defer cancel()
+
 	tag, err := r.client.UpdateTag(ctx, state.ID.ValueString(), plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating tag", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Update, "Error updating tag", err)
 		return
 	}
 
@@ -142,13 +161,16 @@ func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, state.Timeouts, timeouts.Delete)
+	defer cancel()
+
 	err := r.client.DeleteTag(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting tag", err.Error())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Delete, "Error deleting tag", err)
 		return
 	}
 }
 
 func (r *tagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importid.ImportTag(ctx, r.client, req, resp)
 }
@@ -6,7 +6,13 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/timeouts"
 	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -134,8 +140,8 @@ func TestTagClientDelete(t *testing.T) {
 	assert.Equal(t, 1, deleteCallCount, "Delete endpoint should be called exactly once")
 }
 
-// TestTagClientCacheInvalidation validates that tag cache is cleared on write operations
-func TestTagClientCacheInvalidation(t *testing.T) {
+// TestTagClientCacheWriteThrough validates that tag writes update the cache in place
+func TestTagClientCacheWriteThrough(t *testing.T) {
 	listCallCount := 0
 
 	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
@@ -176,14 +182,15 @@ func TestTagClientCacheInvalidation(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1, listCallCount, "Should use cached value")
 
-	// Update - invalidates cache
+	// Update - writes the new tag through to the cache
 	_, err = c.UpdateTag(context.Background(), "tag-1", "updated")
 	require.NoError(t, err)
 
-	// Third read - repopulates cache after invalidation
-	_, err = c.GetTag(context.Background(), "tag-1")
+	// Third read - sees the update without refetching
+	tag, err := c.GetTag(context.Background(), "tag-1")
 	require.NoError(t, err)
-	assert.Equal(t, 2, listCallCount, "Cache should be invalidated after update")
+	assert.Equal(t, "updated", tag.Name)
+	assert.Equal(t, 1, listCallCount, "Update should be written through to the cache")
 }
 
 // TestTagNameValidation validates tag name handling
@@ -246,3 +253,70 @@ func TestTagNameValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestTagReadRemovesDeletedTag validates that a tag deleted outside Terraform
+// is dropped from state instead of failing the refresh
+func TestTagReadRemovesDeletedTag(t *testing.T) {
+	ctx := context.Background()
+
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tags": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(testutil.MockTagsResponse([]client.Tag{testutil.TestTag2}))
+		},
+	})
+	defer server.Close()
+
+	r := &tagResource{client: testutil.NewTestClient(server.URL)}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &tagResourceModel{
+		ID:       types.StringValue(testutil.TestTag1.ID),
+		Name:     types.StringValue(testutil.TestTag1.Name),
+		Timeouts: types.ObjectNull(timeouts.AttrTypes),
+	})
+	require.False(t, diags.HasError())
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "missing tag should not be an error: %v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull(), "missing tag should be removed from state")
+}
+
+// TestTagImportByName validates that "name:<name>" resolves to the tag's ID
+func TestTagImportByName(t *testing.T) {
+	ctx := context.Background()
+
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tags": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(testutil.MockTagsResponse([]client.Tag{testutil.TestTag1, testutil.TestTag2}))
+		},
+	})
+	defer server.Close()
+
+	r := &tagResource{client: testutil.NewTestClient(server.URL)}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "name:" + testutil.TestTag2.Name}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var id types.String
+	resp.State.GetAttribute(ctx, path.Root("id"), &id)
+	assert.Equal(t, testutil.TestTag2.ID, id.ValueString())
+}
@@ -0,0 +1,92 @@
+// Package timeouts holds the timeouts block shared by resources, bounding how
+// long each operation may wait on the Habitica API. The block has the same
+// shape as terraform-plugin-framework-timeouts, so configurations and state
+// carry over should the provider move to that module.
+package timeouts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/validate"
+)
+
+// DefaultTimeout applies to operations without a configured timeout. It is
+// generous because a large apply can spend minutes waiting on rate limits.
+const DefaultTimeout = 20 * time.Minute
+
+// Operation is a resource operation with its own timeout.
+type Operation string
+
+const (
+	Create Operation = "create"
+	Read   Operation = "read"
+	Update Operation = "update"
+	Delete Operation = "delete"
+)
+
+// AttrTypes are the attribute types of the timeouts block.
+var AttrTypes = map[string]attr.Type{
+	string(Create): types.StringType,
+	string(Read):   types.StringType,
+	string(Update): types.StringType,
+	string(Delete): types.StringType,
+}
+
+// Block returns the timeouts schema block.
+func Block() schema.SingleNestedBlock {
+	attributes := make(map[string]schema.Attribute, len(AttrTypes))
+	for _, op := range []Operation{Create, Read, Update, Delete} {
+		attributes[string(op)] = schema.StringAttribute{
+			Description: fmt.Sprintf("How long to wait for the %s operation, as a duration such as '30s' or '10m'. Defaults to %s.", op, DefaultTimeout),
+			Optional:    true,
+			Validators: []validator.String{
+				validate.Duration(),
+			},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "Timeouts for each operation, including any time spent waiting on the API rate limit.",
+		Attributes:  attributes,
+	}
+}
+
+// WithTimeout returns a context that expires once the configured timeout for
+// op has passed. Values are checked when the configuration is validated, so
+// an unparsable one falls back to DefaultTimeout.
+func WithTimeout(ctx context.Context, value types.Object, op Operation) (context.Context, context.CancelFunc) {
+	timeout := DefaultTimeout
+
+	if !value.IsNull() && !value.IsUnknown() {
+		if raw, ok := value.Attributes()[string(op)].(types.String); ok && !raw.IsNull() && !raw.IsUnknown() {
+			if parsed, err := time.ParseDuration(raw.ValueString()); err == nil && parsed > 0 {
+				timeout = parsed
+			}
+		}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// AddError adds err to diags. If the operation's context expired, the detail
+// says so instead of surfacing a bare "context deadline exceeded".
+func AddError(ctx context.Context, diags *diag.Diagnostics, op Operation, summary string, err error) {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		diags.AddError(
+			summary,
+			fmt.Sprintf("The %s operation did not finish before its timeout. Requests may have been waiting on the Habitica rate limit; "+
+				"set timeouts.%s on the resource to allow more time.\n\nLast error: %s", op, op, err),
+		)
+		return
+	}
+
+	diags.AddError(summary, err.Error())
+}
@@ -0,0 +1,99 @@
+package timeouts
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func timeoutsValue(t *testing.T, create string) types.Object {
+	t.Helper()
+
+	value, d := types.ObjectValue(AttrTypes, map[string]attr.Value{
+		"create": types.StringValue(create),
+		"read":   types.StringNull(),
+		"update": types.StringNull(),
+		"delete": types.StringNull(),
+	})
+	require.False(t, d.HasError())
+	return value
+}
+
+func TestWithTimeout(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.Object
+		op    Operation
+		want  time.Duration
+	}{
+		{"no block", types.ObjectNull(AttrTypes), Create, DefaultTimeout},
+		{"configured", timeoutsValue(t, "90s"), Create, 90 * time.Second},
+		{"other operation unset", timeoutsValue(t, "90s"), Delete, DefaultTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			ctx, cancel := WithTimeout(context.Background(), tt.value, tt.op)
+			defer cancel()
+
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			assert.WithinDuration(t, start.Add(tt.want), deadline, time.Second)
+		})
+	}
+}
+
+// TestAddErrorOnExpiry validates that a request stuck past the timeout is
+// reported as a timeout rather than a bare context error
+func TestAddErrorOnExpiry(t *testing.T) {
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tasks/user": func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		},
+	})
+	defer server.Close()
+
+	ctx, cancel := WithTimeout(context.Background(), timeoutsValue(t, "20ms"), Create)
+	defer cancel()
+
+	_, err := testutil.NewTestClient(server.URL).GetAllTasks(ctx)
+	require.Error(t, err)
+
+	var diags diag.Diagnostics
+	AddError(ctx, &diags, Create, "Error creating habit", err)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Error creating habit", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "did not finish before its timeout")
+	assert.Contains(t, diags[0].Detail(), "timeouts.create")
+}
+
+func TestAddErrorPassesThrough(t *testing.T) {
+	var diags diag.Diagnostics
+	AddError(context.Background(), &diags, Update, "Error updating tag", errors.New("API error (400)"))
+	require.Len(t, diags, 1)
+	assert.Equal(t, "API error (400)", diags[0].Detail())
+}
+
+// TestBlock validates that the block has one validated duration per operation
+func TestBlock(t *testing.T) {
+	block := Block()
+	require.Len(t, block.Attributes, len(AttrTypes))
+
+	for name := range AttrTypes {
+		attribute, ok := block.Attributes[name].(schema.StringAttribute)
+		require.True(t, ok, name)
+		assert.True(t, attribute.Optional, name)
+		assert.NotEmpty(t, attribute.Validators, name)
+	}
+}
@@ -0,0 +1,278 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/checklist"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/difficulty"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/importid"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/stat"
+)
+
+var (
+	_ resource.Resource                = &todoResource{}
+	_ resource.ResourceWithConfigure   = &todoResource{}
+	_ resource.ResourceWithImportState = &todoResource{}
+)
+
+// NewResource returns a new todo resource.
+func NewResource() resource.Resource {
+	return &todoResource{}
+}
+
+type todoResource struct {
+	client *client.Client
+}
+
+type todoResourceModel struct {
+	ID         types.String  `tfsdk:"id"`
+	Text       types.String  `tfsdk:"text"`
+	Notes      types.String  `tfsdk:"notes"`
+	Priority   types.Float64 `tfsdk:"priority"`
+	Difficulty types.String  `tfsdk:"difficulty"`
+	Attribute  types.String  `tfsdk:"attribute"`
+	Date       types.String  `tfsdk:"date"`
+	Tags       types.List    `tfsdk:"tags"`
+	Checklist  types.List    `tfsdk:"checklist"`
+	Completed  types.Bool    `tfsdk:"completed"`
+}
+
+func (r *todoResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_todo"
+}
+
+func (r *todoResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Habitica todo (one-off task).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the todo.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"text": schema.StringAttribute{
+				Description: "The title of the todo.",
+				Required:    true,
+			},
+			"notes": schema.StringAttribute{
+				Description: "Extra notes or description for the todo.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"priority":   difficulty.PriorityAttribute("todo"),
+			"difficulty": difficulty.Attribute("todo"),
+			"attribute":  stat.Attribute("todo"),
+			"date": schema.StringAttribute{
+				Description: "Due date in YYYY-MM-DD format.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "List of tag IDs to associate with this todo.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"checklist": checklist.Attribute("todo"),
+			"completed": schema.BoolAttribute{
+				Description: "Whether the todo has been completed in Habitica.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *todoResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *todoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan todoResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task := r.modelToTask(ctx, &plan, &resp.Diagnostics)
+	task.Checklist = checklist.ToItems(ctx, plan.Checklist, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateTask(ctx, task)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating todo", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	r.updateModelFromTask(ctx, &plan, created, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *todoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state todoResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.client.GetTask(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading todo", err.Error())
+		return
+	}
+
+	r.updateModelFromTask(ctx, &state, task, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *todoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan todoResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state todoResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only fields changed since the last refresh are sent, so edits made in
+	// the app to anything else are kept. Unconfigured optional attributes
+	// keep their state value in the plan and so are never sent
+	prior := r.modelToTask(ctx, &state, &resp.Diagnostics)
+	task := r.modelToTask(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.PatchTask(ctx, state.ID.ValueString(), &prior.UpdateTaskRequest, &task.UpdateTaskRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating todo", err.Error())
+		return
+	}
+
+	// Checklist items are managed through their own endpoints so that item
+	// IDs and completion state survive the update
+	if !plan.Checklist.IsNull() && !plan.Checklist.IsUnknown() {
+		desired := checklist.ToItems(ctx, plan.Checklist, &resp.Diagnostics)
+		updated, err = r.client.SyncChecklist(ctx, updated, desired)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating todo checklist", err.Error())
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	r.updateModelFromTask(ctx, &plan, updated, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *todoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state todoResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTask(ctx, state.ID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Error deleting todo", err.Error())
+		return
+	}
+}
+
+func (r *todoResource) modelToTask(ctx context.Context, model *todoResourceModel, diags *diag.Diagnostics) *client.CreateTaskRequest {
+	task := &client.CreateTaskRequest{
+		Type: "todo",
+		UpdateTaskRequest: client.UpdateTaskRequest{
+			Text:      model.Text.ValueString(),
+			Notes:     model.Notes.ValueString(),
+			Priority:  model.Priority.ValueFloat64(),
+			Attribute: model.Attribute.ValueString(),
+		},
+	}
+
+	if !model.Date.IsNull() && !model.Date.IsUnknown() {
+		t, err := time.Parse("2006-01-02", model.Date.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("date"), "Invalid due date", fmt.Sprintf("Expected YYYY-MM-DD, got %q.", model.Date.ValueString()))
+			return task
+		}
+		task.Date = &t
+	}
+
+	if !model.Tags.IsNull() {
+		var tags []string
+		diags.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+		task.Tags = tags
+	}
+
+	return task
+}
+
+func (r *todoResource) updateModelFromTask(ctx context.Context, model *todoResourceModel, task *client.Task, diags *diag.Diagnostics) {
+	model.Text = types.StringValue(task.Text)
+	model.Notes = types.StringValue(task.Notes)
+	model.Priority = types.Float64Value(task.Priority)
+	model.Difficulty = difficulty.FromPriority(task.Priority)
+	model.Attribute = stat.FromTask(task)
+	model.Completed = types.BoolValue(task.Completed)
+
+	if task.Date != nil {
+		model.Date = types.StringValue(task.Date.Format("2006-01-02"))
+	} else {
+		model.Date = types.StringNull()
+	}
+
+	if len(task.Tags) > 0 {
+		tagList, d := types.ListValueFrom(ctx, types.StringType, task.Tags)
+		diags.Append(d...)
+		model.Tags = tagList
+	} else {
+		model.Tags = types.ListNull(types.StringType)
+	}
+
+	model.Checklist = checklist.FromItems(ctx, task.Checklist, diags)
+}
+
+func (r *todoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.ImportTask(ctx, r.client, "todo", req, resp)
+}
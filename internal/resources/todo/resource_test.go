@@ -0,0 +1,131 @@
+package todo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/checklist"
+	"github.com/inannamalick/terraform-provider-habitica/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTodoClientCreate validates that todo fields are sent to the API
+func TestTodoClientCreate(t *testing.T) {
+	server := testutil.NewMockHabiticaServer(t, map[string]http.HandlerFunc{
+		"/tasks/user": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+
+			assert.Equal(t, "todo", body["type"])
+			assert.Equal(t, "Set up laptop", body["text"])
+			assert.Equal(t, "2025-02-01T00:00:00Z", body["date"])
+
+			items, ok := body["checklist"].([]interface{})
+			require.True(t, ok, "checklist should be sent as an array")
+			require.Len(t, items, 2)
+			assert.Equal(t, "Install editor", items[0].(map[string]interface{})["text"])
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(testutil.MockTaskResponse(&testutil.TestTodo1))
+		},
+	})
+	defer server.Close()
+
+	c := testutil.NewTestClient(server.URL)
+	created, err := c.CreateTask(context.Background(), &client.CreateTaskRequest{
+		Type: "todo",
+		UpdateTaskRequest: client.UpdateTaskRequest{
+			Text: "Set up laptop",
+			Date: testutil.TimePtr(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Checklist: []client.ChecklistItem{
+			{Text: "Install editor"},
+			{Text: "Request VPN access"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "todo-uuid-1", created.ID)
+	require.Len(t, created.Checklist, 2)
+	assert.Equal(t, "item-uuid-1", created.Checklist[0].ID)
+}
+
+// TestTodoModelToTask validates conversion from Terraform model to API task
+func TestTodoModelToTask(t *testing.T) {
+	r := &todoResource{}
+	ctx := context.Background()
+
+	model := &todoResourceModel{
+		Text:      types.StringValue("Set up laptop"),
+		Notes:     types.StringValue("Onboarding"),
+		Priority:  types.Float64Value(1.5),
+		Date:      types.StringValue("2025-02-01"),
+		Tags:      types.ListNull(types.StringType),
+		Checklist: types.ListUnknown(checklist.ElementType),
+	}
+
+	var diags diag.Diagnostics
+	task := r.modelToTask(ctx, model, &diags)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "todo", task.Type)
+	assert.Equal(t, 1.5, task.Priority)
+	require.NotNil(t, task.Date)
+	assert.Equal(t, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), *task.Date)
+	assert.Nil(t, task.Tags)
+	assert.Nil(t, task.Checklist, "checklist is sent separately from the task body")
+}
+
+// TestTodoModelToTaskInvalidDate validates that malformed due dates are rejected
+func TestTodoModelToTaskInvalidDate(t *testing.T) {
+	r := &todoResource{}
+	model := &todoResourceModel{
+		Text:      types.StringValue("Set up laptop"),
+		Date:      types.StringValue("02/01/2025"),
+		Tags:      types.ListNull(types.StringType),
+		Checklist: types.ListNull(checklist.ElementType),
+	}
+
+	var diags diag.Diagnostics
+	r.modelToTask(context.Background(), model, &diags)
+	assert.True(t, diags.HasError())
+}
+
+// TestTodoUpdateModelFromTask validates that API state round-trips into the model
+func TestTodoUpdateModelFromTask(t *testing.T) {
+	r := &todoResource{}
+	ctx := context.Background()
+
+	var model todoResourceModel
+	var diags diag.Diagnostics
+	r.updateModelFromTask(ctx, &model, &testutil.TestTodo1, &diags)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "Set up laptop", model.Text.ValueString())
+	assert.Equal(t, "2025-02-01", model.Date.ValueString())
+	assert.False(t, model.Completed.ValueBool())
+
+	var items []checklist.ItemModel
+	require.False(t, model.Checklist.ElementsAs(ctx, &items, false).HasError())
+	require.Len(t, items, 2)
+	assert.Equal(t, "item-uuid-1", items[0].ID.ValueString())
+	assert.True(t, items[0].Completed.ValueBool())
+	assert.Equal(t, "Request VPN access", items[1].Text.ValueString())
+
+	// A todo without a due date or checklist clears the date and empties the checklist
+	r.updateModelFromTask(ctx, &model, &client.Task{ID: "todo-uuid-2", Type: "todo", Text: "Bare"}, &diags)
+	require.False(t, diags.HasError())
+	assert.True(t, model.Date.IsNull())
+	assert.Empty(t, model.Checklist.Elements())
+	assert.True(t, model.Tags.IsNull())
+}
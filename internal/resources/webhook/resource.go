@@ -2,10 +2,10 @@ package webhook
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/inannamalick/terraform-provider-habitica/internal/client"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/importid"
+	"github.com/inannamalick/terraform-provider-habitica/internal/resources/timeouts"
 )
 
 var (
@@ -31,12 +33,13 @@ type webhookResource struct {
 }
 
 type webhookResourceModel struct {
-	ID      types.String  `tfsdk:"id"`
-	URL     types.String  `tfsdk:"url"`
-	Label   types.String  `tfsdk:"label"`
-	Type    types.String  `tfsdk:"type"`
-	Enabled types.Bool    `tfsdk:"enabled"`
-	Options *optionsModel `tfsdk:"options"`
+	ID       types.String  `tfsdk:"id"`
+	URL      types.String  `tfsdk:"url"`
+	Label    types.String  `tfsdk:"label"`
+	Type     types.String  `tfsdk:"type"`
+	Enabled  types.Bool    `tfsdk:"enabled"`
+	Options  *optionsModel `tfsdk:"options"`
+	Timeouts types.Object  `tfsdk:"timeouts"`
 }
 
 type optionsModel struct {
@@ -118,6 +121,9 @@ func (r *webhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(),
+		},
 	}
 }
 
@@ -145,11 +151,14 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, plan.Timeouts, timeouts.Create)
+	defer cancel()
+
 	webhook := r.modelToWebhook(&plan)
 
 	created, err := r.client.CreateWebhook(ctx, webhook)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating webhook", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Create, "Error creating webhook", err)
 		return
 	}
 
@@ -166,9 +175,16 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, state.Timeouts, timeouts.Read)
+	defer cancel()
+
 	webhook, err := r.client.GetWebhook(ctx, state.ID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading webhook", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Read, "Error reading webhook", err)
 		return
 	}
 
@@ -190,11 +206,14 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, plan.Timeouts, timeouts.Update)
+	defer cancel()
+
 	webhook := r.modelToWebhook(&plan)
 
 	updated, err := r.client.UpdateWebhook(ctx, state.ID.ValueString(), webhook)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating webhook", err.Error())
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Update, "Error updating webhook", err)
 		return
 	}
 
@@ -211,9 +230,12 @@ func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := timeouts.WithTimeout(ctx, state.Timeouts, timeouts.Delete)
+	defer cancel()
+
 	err := r.client.DeleteWebhook(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting webhook", err.Error())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		timeouts.AddError(ctx, &resp.Diagnostics, timeouts.Delete, "Error deleting webhook", err)
 		return
 	}
 }
@@ -255,5 +277,5 @@ func (r *webhookResource) updateModelFromWebhook(model *webhookResourceModel, we
 }
 
 func (r *webhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importid.ImportWebhook(ctx, r.client, req, resp)
 }
@@ -66,7 +66,11 @@ var (
 			Saturday:  false,
 			Sunday:    false,
 		},
-		Tags:      []string{"tag-uuid-3"},
+		Tags: []string{"tag-uuid-3"},
+		Checklist: []client.ChecklistItem{
+			{ID: "item-uuid-3", Text: "Brush teeth", Completed: true},
+			{ID: "item-uuid-4", Text: "Shower", Completed: false},
+		},
 		Completed: false,
 		IsDue:     true,
 		Streak:    5,
@@ -93,6 +97,32 @@ var (
 		Streak:    30,
 	}
 
+	// Todos
+	TestTodo1 = client.Task{
+		ID:       "todo-uuid-1",
+		Type:     "todo",
+		Text:     "Set up laptop",
+		Notes:    "Onboarding",
+		Priority: 1,
+		Date:     TimePtr(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)),
+		Tags:     []string{"tag-uuid-1"},
+		Checklist: []client.ChecklistItem{
+			{ID: "item-uuid-1", Text: "Install editor", Completed: true},
+			{ID: "item-uuid-2", Text: "Request VPN access", Completed: false},
+		},
+		Completed: false,
+	}
+
+	// Rewards
+	TestReward1 = client.Task{
+		ID:    "reward-uuid-1",
+		Type:  "reward",
+		Text:  "Coffee break",
+		Notes: "Treat yourself",
+		Value: Float64Ptr(25),
+		Tags:  []string{"tag-uuid-1"},
+	}
+
 	// Webhooks
 	TestWebhook1 = client.Webhook{
 		ID:      "webhook-uuid-1",
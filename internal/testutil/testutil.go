@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
@@ -93,11 +94,13 @@ func MockErrorResponse(statusCode int, message string) []byte {
 	return bytes
 }
 
-// MockRateLimitHeaders returns HTTP headers with rate limit information
+// MockRateLimitHeaders returns HTTP headers with rate limit information in
+// the format Habitica sends them
 func MockRateLimitHeaders(remaining int, resetTime time.Time) http.Header {
 	headers := http.Header{}
-	headers.Set("X-RateLimit-Remaining", string(rune(remaining+'0')))
-	headers.Set("X-RateLimit-Reset", resetTime.Format(time.RFC3339))
+	headers.Set("X-RateLimit-Limit", "30")
+	headers.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	headers.Set("X-RateLimit-Reset", resetTime.UTC().Format("Mon Jan 02 2006 15:04:05 GMT-0700")+" (Coordinated Universal Time)")
 	return headers
 }
 
@@ -123,3 +126,13 @@ func BoolPtr(b bool) *bool {
 func TimePtr(t time.Time) *time.Time {
 	return &t
 }
+
+// Float64Ptr returns a pointer to a float64 value
+func Float64Ptr(f float64) *float64 {
+	return &f
+}
+
+// StringPtr returns a pointer to a string value
+func StringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,115 @@
+// Package validate holds schema validators shared by resources and data
+// sources.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Duration returns a validator that accepts only positive Go durations such
+// as "30s" or "10m".
+func Duration() validator.String {
+	return durationValidator{}
+}
+
+// OneOf returns a validator that accepts only the given strings.
+func OneOf(values ...string) validator.String {
+	return oneOfValidator{values: values}
+}
+
+// OneOfFloat64 returns a validator that accepts only the given numbers.
+func OneOfFloat64(values ...float64) validator.Float64 {
+	return oneOfFloat64Validator{values: values}
+}
+
+type oneOfValidator struct {
+	values []string
+}
+
+func (v oneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(v.values, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Expected one of %s, got: %q", strings.Join(v.values, ", "), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+type oneOfFloat64Validator struct {
+	values []float64
+}
+
+func (v oneOfFloat64Validator) list() string {
+	values := make([]string, len(v.values))
+	for i, value := range v.values {
+		values[i] = strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return strings.Join(values, ", ")
+}
+
+func (v oneOfFloat64Validator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", v.list())
+}
+
+func (v oneOfFloat64Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfFloat64Validator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(v.values, req.ConfigValue.ValueFloat64()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Expected one of %s, got: %s", v.list(), strconv.FormatFloat(req.ConfigValue.ValueFloat64(), 'f', -1, 64)),
+		)
+	}
+}
+
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as '30s' or '10m'"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a positive duration such as '30s' or '10m', got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOneOf(t *testing.T) {
+	v := OneOf("daily", "weekly")
+
+	for value, valid := range map[types.String]bool{
+		types.StringValue("daily"):  true,
+		types.StringValue("weekly"): true,
+		types.StringValue("Daily"):  false,
+		types.StringValue(""):       false,
+		types.StringNull():          true,
+		types.StringUnknown():       true,
+	} {
+		req := validator.StringRequest{Path: path.Root("frequency"), ConfigValue: value}
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), req, resp)
+		assert.Equal(t, !valid, resp.Diagnostics.HasError(), "%s", value)
+	}
+
+	assert.Equal(t, "value must be one of: daily, weekly", v.Description(context.Background()))
+}
+
+func TestOneOfFloat64(t *testing.T) {
+	v := OneOfFloat64(0.1, 1.5)
+
+	for value, valid := range map[types.Float64]bool{
+		types.Float64Value(0.1): true,
+		types.Float64Value(1.5): true,
+		types.Float64Value(1):   false,
+		types.Float64Null():     true,
+		types.Float64Unknown():  true,
+	} {
+		req := validator.Float64Request{Path: path.Root("priority"), ConfigValue: value}
+		resp := &validator.Float64Response{}
+		v.ValidateFloat64(context.Background(), req, resp)
+		assert.Equal(t, !valid, resp.Diagnostics.HasError(), "%s", value)
+	}
+
+	assert.Equal(t, "value must be one of: 0.1, 1.5", v.Description(context.Background()))
+}
+
+func TestDuration(t *testing.T) {
+	for value, valid := range map[string]bool{
+		"30s":  true,
+		"10m":  true,
+		"0s":   false,
+		"-5m":  false,
+		"soon": false,
+		"10":   false,
+	} {
+		req := validator.StringRequest{Path: path.Root("timeouts").AtName("create"), ConfigValue: types.StringValue(value)}
+		resp := &validator.StringResponse{}
+		Duration().ValidateString(context.Background(), req, resp)
+		assert.Equal(t, !valid, resp.Diagnostics.HasError(), "%q", value)
+	}
+}